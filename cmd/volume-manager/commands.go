@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"gopkg.in/urfave/cli.v2"
+)
+
+var RecalculateStoragesCommand = cli.Command{
+	Name:  "recalculate-storages",
+	Usage: "Recalculate storages used capacity from live volumes",
+	Flags: []cli.Flag{
+		&DryRunFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		db, err := setupDB(ctx)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		srv := server.NewServer(db, &server.Clients{})
+		report, err := srv.RecalculateStoragesUsage(context.Background(), ctx.Bool(DryRunFlag.Name))
+		if err != nil {
+			return err
+		}
+
+		if len(report.Discrepancies) == 0 {
+			fmt.Println("Storages usage is consistent")
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', tabwriter.TabIndent|tabwriter.Debug)
+		fmt.Fprintln(w, "Storage\t Recorded\t Actual")
+		for _, check := range report.Discrepancies {
+			fmt.Fprintf(w, "%s\t %s\t %s\n", check.Name, check.Recorded, check.Actual)
+		}
+		w.Flush()
+
+		if report.DryRun {
+			fmt.Println("Dry run, nothing changed")
+		} else {
+			fmt.Printf("Fixed %d storages\n", len(report.Discrepancies))
+		}
+		return nil
+	},
+}
@@ -3,19 +3,26 @@ package main
 import (
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/url"
+	"path/filepath"
 	"reflect"
 
+	"git.containerum.net/ch/volume-manager/pkg/alerts"
 	"git.containerum.net/ch/volume-manager/pkg/clients"
 	"git.containerum.net/ch/volume-manager/pkg/database"
 	"git.containerum.net/ch/volume-manager/pkg/database/postgres"
+	"git.containerum.net/ch/volume-manager/pkg/router"
 	"git.containerum.net/ch/volume-manager/pkg/server"
+	"git.containerum.net/ch/volume-manager/pkg/utils/validation"
+	"git.containerum.net/ch/volume-manager/pkg/webhooks"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/locales/en"
 	"github.com/go-playground/locales/en_US"
 	"github.com/go-playground/universal-translator"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/urfave/cli.v2"
+	"gopkg.in/yaml.v2"
 )
 
 type operationMode int
@@ -77,37 +84,174 @@ func setupTranslator() *ut.UniversalTranslator {
 	return ut.New(en.New(), en.New(), en_US.New())
 }
 
-func setupBillingClient(addr string) (clients.BillingClient, error) {
+func setupTranslateValidate() *router.TranslateValidate {
+	translate := setupTranslator()
+	return &router.TranslateValidate{UniversalTranslator: translate, Validate: validation.StandardPermissionsValidator(translate)}
+}
+
+func setupResilienceConfig(ctx *cli.Context) clients.ResilienceConfig {
+	return clients.ResilienceConfig{
+		Timeout:          ctx.Duration(ClientTimeoutFlag.Name),
+		Retries:          ctx.Int(ClientRetriesFlag.Name),
+		RetryWaitTime:    ctx.Duration(ClientRetryWaitFlag.Name),
+		RetryMaxWaitTime: ctx.Duration(ClientRetryMaxWaitFlag.Name),
+		BreakerThreshold: ctx.Int(ClientBreakerThresholdFlag.Name),
+		BreakerTimeout:   ctx.Duration(ClientBreakerTimeoutFlag.Name),
+		Debug:            ctx.Bool(ClientDebugFlag.Name),
+	}
+}
+
+func setupBillingClient(addr, fakeDataFile string, cfg clients.ResilienceConfig) (clients.BillingClient, error) {
 	switch {
+	case addr == "" && fakeDataFile != "":
+		data, err := clients.LoadBillingFakeData(fakeDataFile)
+		if err != nil {
+			return nil, err
+		}
+		return clients.NewBillingDummyClientWithData(data, fakeDataFile), nil
 	case opMode == modeDebug && addr == "":
 		return clients.NewBillingDummyClient(), nil
 	case addr != "":
-		return clients.NewBillingHTTPClient(&url.URL{Scheme: "http", Host: addr}), nil
+		return clients.NewBillingHTTPClient(&url.URL{Scheme: "http", Host: addr}, cfg), nil
 	default:
 		return nil, errors.New("missing configuration for billing service")
 	}
 }
 
-func setupKubeAPIClient(addr string) (clients.KubeAPIClient, error) {
+const (
+	kubeBackendKubeAPI    = "kube-api"
+	kubeBackendKubernetes = "kubernetes"
+	kubeBackendDummy      = "dummy"
+)
+
+// kubeClusterConfig describes kube backend of cluster.
+// Default cluster is configured by flags, additional clusters are read from clusters config file.
+type kubeClusterConfig struct {
+	Name        string   `yaml:"name"`
+	Backend     string   `yaml:"backend"`
+	KubeAPIAddr string   `yaml:"kube_api_addr"`
+	Host        string   `yaml:"kube_host"`
+	TokenFile   string   `yaml:"kube_token_file"`
+	CAFile      string   `yaml:"kube_ca_file"`
+	Insecure    bool     `yaml:"kube_insecure"`
+	Storages    []string `yaml:"storages"`
+}
+
+func defaultKubeClusterConfig(ctx *cli.Context) kubeClusterConfig {
+	return kubeClusterConfig{
+		Name:        ctx.String(KubeClusterFlag.Name),
+		Backend:     ctx.String(KubeBackendFlag.Name),
+		KubeAPIAddr: ctx.String(KubeAPIAddrFlag.Name),
+		Host:        ctx.String(KubeHostFlag.Name),
+		TokenFile:   ctx.String(KubeTokenFileFlag.Name),
+		CAFile:      ctx.String(KubeCAFileFlag.Name),
+		Insecure:    ctx.Bool(KubeInsecureFlag.Name),
+	}
+}
+
+func loadKubeClustersConfig(path string) ([]kubeClusterConfig, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ret []kubeClusterConfig
+	if err := yaml.UnmarshalStrict(data, &ret); err != nil {
+		return nil, fmt.Errorf("%s: %v", filepath.Base(path), err)
+	}
+	return ret, nil
+}
+
+func setupKubeDirectConfig(cluster kubeClusterConfig) (clients.KubeDirectConfig, error) {
+	if cluster.Host == "" {
+		return clients.InClusterKubeDirectConfig()
+	}
+	return clients.KubeDirectConfig{
+		Host:      cluster.Host,
+		TokenFile: cluster.TokenFile,
+		CAFile:    cluster.CAFile,
+		Insecure:  cluster.Insecure,
+	}, nil
+}
+
+// backend returns configured kube backend or the one chosen by default
+func (c kubeClusterConfig) backend() string {
 	switch {
-	case opMode == modeDebug && addr == "":
+	case c.Backend != "":
+		return c.Backend
+	case opMode == modeDebug && c.KubeAPIAddr == "":
+		return kubeBackendDummy
+	default:
+		return kubeBackendKubeAPI
+	}
+}
+
+func setupKubeAPIClient(cluster kubeClusterConfig, cfg clients.ResilienceConfig) (clients.KubeAPIClient, error) {
+	switch backend := cluster.backend(); backend {
+	case kubeBackendKubernetes:
+		directCfg, err := setupKubeDirectConfig(cluster)
+		if err != nil {
+			return nil, err
+		}
+		return clients.NewKubeDirectClient(directCfg, cfg)
+	case kubeBackendDummy:
 		return clients.NewKubeAPIDummyClient(), nil
-	case addr != "":
-		return clients.NewKubeAPIHTTPClient(&url.URL{Scheme: "http", Host: addr}), nil
+	case kubeBackendKubeAPI:
+		if cluster.KubeAPIAddr == "" {
+			return nil, errors.New("missing configuration for kube-api service")
+		}
+		return clients.NewKubeAPIHTTPClient(&url.URL{Scheme: "http", Host: cluster.KubeAPIAddr}, cfg), nil
 	default:
-		return nil, errors.New("missing configuration for billing service")
+		return nil, fmt.Errorf("unknown kube backend %q", backend)
 	}
 }
 
+func setupKubeClusters(ctx *cli.Context, cfg clients.ResilienceConfig) (*clients.KubeClusters, error) {
+	configs := []kubeClusterConfig{defaultKubeClusterConfig(ctx)}
+	if path := ctx.String(KubeClustersConfigFlag.Name); path != "" {
+		additional, err := loadKubeClustersConfig(path)
+		if err != nil {
+			return nil, err
+		}
+		configs = append(configs, additional...)
+	}
+
+	clusters := make([]clients.KubeCluster, 0, len(configs))
+	for _, config := range configs {
+		client, err := setupKubeAPIClient(config, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("cluster %s: %v", config.Name, err)
+		}
+		clusters = append(clusters, clients.KubeCluster{
+			Name:     config.Name,
+			Client:   client,
+			Backend:  config.backend(),
+			Storages: config.Storages,
+		})
+	}
+	return clients.NewKubeClusters(configs[0].Name, clusters...)
+}
+
 func setupServiceClients(ctx *cli.Context) (*server.Clients, error) {
+	if ctx.Duration(BillingReconcileIntervalFlag.Name) > 0 && ctx.String(BillingAddrFlag.Name) != "" {
+		return nil, errors.New("billing reconciliation is not supported with billing service, it can not list subscriptions")
+	}
+
 	var errs []error
 	var serverClients server.Clients
 	var err error
 
-	if serverClients.Billing, err = setupBillingClient(ctx.String(BillingAddrFlag.Name)); err != nil {
+	resilienceCfg := setupResilienceConfig(ctx)
+
+	if serverClients.Billing, err = setupBillingClient(ctx.String(BillingAddrFlag.Name), ctx.String(BillingFakeDataFlag.Name), resilienceCfg); err != nil {
 		errs = append(errs, err)
 	}
-	if serverClients.KubeAPI, err = setupKubeAPIClient(ctx.String(KubeAPIAddrFlag.Name)); err != nil {
+	if cacheSize := ctx.Int(TariffCacheSizeFlag.Name); serverClients.Billing != nil && cacheSize > 0 {
+		serverClients.Billing = clients.NewBillingCachedClient(serverClients.Billing, clients.TariffCacheConfig{
+			TTL:  ctx.Duration(TariffCacheTTLFlag.Name),
+			Size: cacheSize,
+		})
+	}
+	if serverClients.Kube, err = setupKubeClusters(ctx, resilienceCfg); err != nil {
 		errs = append(errs, err)
 	}
 
@@ -125,3 +269,38 @@ func setupServiceClients(ctx *cli.Context) (*server.Clients, error) {
 
 	return &serverClients, nil
 }
+
+func setupServer(ctx *cli.Context) (*server.Server, error) {
+	db, err := setupDB(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := setupServiceClients(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifier, err := setupCapacityAlertsNotifier(ctx.String(CapacityAlertWebhookFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return server.NewServer(db, clients,
+		server.WithStorageClassesAllowList(ctx.StringSlice(StorageClassesAllowFlag.Name)...),
+		server.WithCapacityAlerts(notifier, ctx.Float64Slice(CapacityAlertThresholdsFlag.Name)...),
+		server.WithWebhooks(webhooks.NewHTTPSender(ctx.Duration(WebhookTimeoutFlag.Name)),
+			ctx.Float64Slice(WebhookNearFullThresholdsFlag.Name)...),
+	), nil
+}
+
+func setupCapacityAlertsNotifier(webhook string) (alerts.Notifier, error) {
+	if webhook == "" {
+		return alerts.NewLogNotifier(), nil
+	}
+	u, err := url.Parse(webhook)
+	if err != nil {
+		return nil, fmt.Errorf("invalid capacity alerts webhook: %v", err)
+	}
+	return alerts.MultiNotifier{alerts.NewLogNotifier(), alerts.NewWebhookNotifier(u)}, nil
+}
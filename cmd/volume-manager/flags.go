@@ -1,6 +1,9 @@
 package main
 
 import (
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/urfave/cli.v2"
 )
@@ -53,17 +56,225 @@ var (
 		Value:   ":4343",
 	}
 
+	GRPCListenAddrFlag = cli.StringFlag{
+		Name:    "grpc_listen_addr",
+		EnvVars: []string{"GRPC_LISTEN_ADDR"},
+		Usage:   "gRPC API listen address, gRPC API is disabled if empty",
+	}
+
 	BillingAddrFlag = cli.StringFlag{
 		Name:    "billing_addr",
 		EnvVars: []string{"BILLING_ADDR"},
 	}
 
+	BillingFakeDataFlag = cli.StringFlag{
+		Name:    "billing_fake_data",
+		EnvVars: []string{"BILLING_FAKE_DATA"},
+		Usage:   "YAML or JSON file with tariffs for in-process fake billing, used if billing address not set",
+	}
+
 	KubeAPIAddrFlag = cli.StringFlag{
 		Name:    "kube_api_addr",
 		EnvVars: []string{"KUBE_API_ADDR"},
 	}
 
+	KubeClusterFlag = cli.StringFlag{
+		Name:    "kube_cluster",
+		EnvVars: []string{"KUBE_CLUSTER"},
+		Usage:   "name of default cluster configured by kube flags, storages not placed in other clusters belong to it",
+		Value:   "default",
+	}
+
+	KubeClustersConfigFlag = cli.StringFlag{
+		Name:    "kube_clusters_config",
+		EnvVars: []string{"KUBE_CLUSTERS_CONFIG"},
+		Usage:   "YAML file with additional clusters: list of name, storages and kube flags (backend, kube_api_addr, kube_host, ...)",
+	}
+
+	KubeBackendFlag = cli.StringFlag{
+		Name:    "kube_backend",
+		EnvVars: []string{"KUBE_BACKEND"},
+		Usage:   "volumes backend: kube-api, kubernetes (direct access to kubernetes API) or dummy, chosen by kube_api_addr and mode if not set",
+	}
+
+	KubeHostFlag = cli.StringFlag{
+		Name:    "kube_host",
+		EnvVars: []string{"KUBE_HOST"},
+		Usage:   "kubernetes API server URL for kubernetes backend, in-cluster service account used if not set",
+	}
+
+	KubeTokenFileFlag = cli.StringFlag{
+		Name:    "kube_token_file",
+		EnvVars: []string{"KUBE_TOKEN_FILE"},
+		Usage:   "file with bearer token for kubernetes API",
+	}
+
+	KubeCAFileFlag = cli.StringFlag{
+		Name:    "kube_ca_file",
+		EnvVars: []string{"KUBE_CA_FILE"},
+		Usage:   "CA certificate of kubernetes API server",
+	}
+
+	KubeInsecureFlag = cli.BoolFlag{
+		Name:    "kube_insecure",
+		EnvVars: []string{"KUBE_INSECURE"},
+		Usage:   "skip kubernetes API server certificate verification",
+	}
+
+	ClientTimeoutFlag = cli.DurationFlag{
+		Name:    "client_timeout",
+		EnvVars: []string{"CLIENT_TIMEOUT"},
+		Usage:   "timeout of single request to billing and kube-api (no timeout if zero)",
+		Value:   clients.DefaultResilienceConfig.Timeout,
+	}
+
+	ClientRetriesFlag = cli.IntFlag{
+		Name:    "client_retries",
+		EnvVars: []string{"CLIENT_RETRIES"},
+		Usage:   "number of retries of failed idempotent requests to billing and kube-api",
+		Value:   clients.DefaultResilienceConfig.Retries,
+	}
+
+	ClientRetryWaitFlag = cli.DurationFlag{
+		Name:    "client_retry_wait",
+		EnvVars: []string{"CLIENT_RETRY_WAIT"},
+		Usage:   "initial wait time between retries, grows exponentially",
+		Value:   clients.DefaultResilienceConfig.RetryWaitTime,
+	}
+
+	ClientRetryMaxWaitFlag = cli.DurationFlag{
+		Name:    "client_retry_max_wait",
+		EnvVars: []string{"CLIENT_RETRY_MAX_WAIT"},
+		Usage:   "maximum wait time between retries",
+		Value:   clients.DefaultResilienceConfig.RetryMaxWaitTime,
+	}
+
+	ClientBreakerThresholdFlag = cli.IntFlag{
+		Name:    "client_breaker_threshold",
+		EnvVars: []string{"CLIENT_BREAKER_THRESHOLD"},
+		Usage:   "number of consecutive failures which opens circuit breaker (disabled if zero)",
+		Value:   clients.DefaultResilienceConfig.BreakerThreshold,
+	}
+
+	ClientBreakerTimeoutFlag = cli.DurationFlag{
+		Name:    "client_breaker_timeout",
+		EnvVars: []string{"CLIENT_BREAKER_TIMEOUT"},
+		Usage:   "time after which open circuit breaker lets trial request through",
+		Value:   clients.DefaultResilienceConfig.BreakerTimeout,
+	}
+
+	ClientDebugFlag = cli.BoolFlag{
+		Name:    "client_debug",
+		EnvVars: []string{"CLIENT_DEBUG"},
+		Usage:   "log requests and responses of billing and kube-api clients",
+	}
+
 	CORSFlag = cli.BoolFlag{
 		Name: "cors",
 	}
+
+	StorageClassesAllowFlag = cli.StringSliceFlag{
+		Name:    "storage_classes_allow",
+		EnvVars: []string{"STORAGE_CLASSES_ALLOW"},
+		Usage:   "storage classes synchronized with storages (all if empty)",
+	}
+
+	StorageSyncIntervalFlag = cli.DurationFlag{
+		Name:    "storage_sync_interval",
+		EnvVars: []string{"STORAGE_SYNC_INTERVAL"},
+		Usage:   "interval of storages synchronization with storage classes (disabled if zero)",
+	}
+
+	UsageSnapshotIntervalFlag = cli.DurationFlag{
+		Name:    "usage_snapshot_interval",
+		EnvVars: []string{"USAGE_SNAPSHOT_INTERVAL"},
+		Usage:   "interval of storages usage snapshots used for forecasting, one snapshot per day is kept (disabled if zero)",
+		Value:   time.Hour,
+	}
+
+	BillingReconcileIntervalFlag = cli.DurationFlag{
+		Name:    "billing_reconcile_interval",
+		EnvVars: []string{"BILLING_RECONCILE_INTERVAL"},
+		Usage:   "interval of billing subscriptions reconciliation with volumes, requires fake billing as billing API can not list subscriptions (disabled if zero)",
+	}
+
+	BillingReconcileFixFlag = cli.BoolFlag{
+		Name:    "billing_reconcile_fix",
+		EnvVars: []string{"BILLING_RECONCILE_FIX"},
+		Usage:   "fix differences found by scheduled billing reconciliation",
+	}
+
+	TariffCacheTTLFlag = cli.DurationFlag{
+		Name:    "tariff_cache_ttl",
+		EnvVars: []string{"TARIFF_CACHE_TTL"},
+		Usage:   "time to live of cached billing tariffs",
+		Value:   clients.DefaultTariffCacheConfig.TTL,
+	}
+
+	TariffCacheSizeFlag = cli.IntFlag{
+		Name:    "tariff_cache_size",
+		EnvVars: []string{"TARIFF_CACHE_SIZE"},
+		Usage:   "maximum number of cached billing tariffs (cache disabled if zero)",
+		Value:   clients.DefaultTariffCacheConfig.Size,
+	}
+
+	CapacityAlertThresholdsFlag = cli.Float64SliceFlag{
+		Name:    "capacity_alert_thresholds",
+		EnvVars: []string{"CAPACITY_ALERT_THRESHOLDS"},
+		Usage:   "storage fill and namespace quota usage thresholds in percents, e.g. 80,95 (alerts disabled if empty)",
+	}
+
+	CapacityAlertWebhookFlag = cli.StringFlag{
+		Name:    "capacity_alert_webhook",
+		EnvVars: []string{"CAPACITY_ALERT_WEBHOOK"},
+		Usage:   "URL where capacity alerts are posted in addition to log",
+	}
+
+	CapacityCheckIntervalFlag = cli.DurationFlag{
+		Name:    "capacity_check_interval",
+		EnvVars: []string{"CAPACITY_CHECK_INTERVAL"},
+		Usage:   "interval of capacity thresholds evaluation (disabled if zero)",
+	}
+
+	VolumeStatusIntervalFlag = cli.DurationFlag{
+		Name:    "volume_status_interval",
+		EnvVars: []string{"VOLUME_STATUS_INTERVAL"},
+		Usage:   "interval of volume statuses polling from kube backend (disabled if zero)",
+		Value:   time.Minute,
+	}
+
+	VolumeWatchFlag = cli.BoolFlag{
+		Name:    "volume_watch",
+		EnvVars: []string{"VOLUME_WATCH"},
+		Usage:   "watch volume changes if kube backend supports it, in addition to polling",
+		Value:   true,
+	}
+
+	WebhookDeliveryIntervalFlag = cli.DurationFlag{
+		Name:    "webhook_delivery_interval",
+		EnvVars: []string{"WEBHOOK_DELIVERY_INTERVAL"},
+		Usage:   "interval of sending due webhook deliveries (disabled if zero)",
+		Value:   10 * time.Second,
+	}
+
+	WebhookTimeoutFlag = cli.DurationFlag{
+		Name:    "webhook_timeout",
+		EnvVars: []string{"WEBHOOK_TIMEOUT"},
+		Usage:   "timeout of webhook delivery request",
+		Value:   10 * time.Second,
+	}
+
+	WebhookNearFullThresholdsFlag = cli.Float64SliceFlag{
+		Name:    "webhook_near_full_thresholds",
+		EnvVars: []string{"WEBHOOK_NEAR_FULL_THRESHOLDS"},
+		Usage:   "storage fill thresholds in percents for storage.near_full webhook event (event disabled if empty)",
+		Value:   cli.NewFloat64Slice(90),
+	}
+)
+
+var (
+	DryRunFlag = cli.BoolFlag{
+		Name:  "dry_run",
+		Usage: "only report changes without applying them",
+	}
 )
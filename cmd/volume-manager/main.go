@@ -3,6 +3,7 @@ package main
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -10,8 +11,10 @@ import (
 	"time"
 
 	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/grpcserver"
 	"git.containerum.net/ch/volume-manager/pkg/router"
 	"git.containerum.net/ch/volume-manager/pkg/server"
+	"git.containerum.net/ch/volume-manager/pkg/utils/periodic"
 	"git.containerum.net/ch/volume-manager/pkg/utils/validation"
 	"github.com/containerum/cherry/adaptors/cherrylog"
 	"github.com/containerum/cherry/adaptors/gonic"
@@ -22,6 +25,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
 	"gopkg.in/urfave/cli.v2"
 )
 
@@ -54,7 +58,85 @@ func prettyPrintFlags(ctx *cli.Context) {
 	w.Flush()
 }
 
-const httpServerContextKey = "httpsrv"
+func setupHTTPServer(ctx *cli.Context, srv *server.Server) *http.Server {
+	listenAddr := getListenAddr(ctx)
+
+	tv := setupTranslateValidate()
+
+	g := gin.New()
+	g.Use(gonic.Recovery(errors.ErrInternal, cherrylog.NewLogrusAdapter(logrus.WithField("component", "gin_recovery"))))
+	g.Use(ginrus.Ginrus(logrus.StandardLogger(), time.RFC3339, true))
+	binding.Validator = &validation.GinValidatorV9{Validate: tv.Validate} // gin has no local validator
+
+	if ctx.Bool(CORSFlag.Name) {
+		corsCfg := cors.DefaultConfig()
+		corsCfg.AllowAllOrigins = true
+		corsCfg.AddAllowHeaders(
+			httputil.UserIDXHeader,
+			httputil.UserRoleXHeader,
+		)
+		g.Use(cors.New(corsCfg))
+	}
+
+	status := model.ServiceStatus{
+		Name:     ctx.App.Name,
+		Version:  ctx.App.Version,
+		StatusOK: true,
+	}
+
+	r := router.NewRouter(g, &status, tv, srv)
+	r.SetupVolumeHandlers(srv)
+	r.SetupStorageHandlers(srv)
+	r.SetupSuspensionHandlers(srv)
+	r.SetupBillingDebugHandlers(srv)
+	r.SetupWebhookHandlers(srv)
+
+	// for graceful shutdown
+	return &http.Server{
+		Addr:    listenAddr,
+		Handler: g,
+	}
+}
+
+// setupGRPCServer returns gRPC server with its listener, server is nil if gRPC API is disabled
+func setupGRPCServer(ctx *cli.Context, srv *server.Server) (*grpc.Server, net.Listener, error) {
+	listenAddr := ctx.String(GRPCListenAddrFlag.Name)
+	if listenAddr == "" {
+		return nil, nil, nil
+	}
+
+	listener, err := net.Listen("tcp", listenAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return grpcserver.NewServer(srv, srv, setupTranslateValidate()), listener, nil
+}
+
+func runJobs(ctx context.Context, cliCtx *cli.Context, srv *server.Server) {
+	go periodic.Run(ctx, "storages_sync", cliCtx.Duration(StorageSyncIntervalFlag.Name), func(ctx context.Context) error {
+		_, err := srv.SyncStorages(server.SystemContext(ctx), false)
+		return err
+	})
+	go periodic.Run(ctx, "usage_snapshot", cliCtx.Duration(UsageSnapshotIntervalFlag.Name), func(ctx context.Context) error {
+		return srv.RecordUsageSnapshot(server.SystemContext(ctx))
+	})
+	go periodic.Run(ctx, "billing_reconcile", cliCtx.Duration(BillingReconcileIntervalFlag.Name), func(ctx context.Context) error {
+		_, err := srv.ReconcileBilling(server.SystemContext(ctx), cliCtx.Bool(BillingReconcileFixFlag.Name))
+		return err
+	})
+	go periodic.Run(ctx, "capacity_check", cliCtx.Duration(CapacityCheckIntervalFlag.Name), func(ctx context.Context) error {
+		return srv.CheckCapacityThresholds(server.SystemContext(ctx))
+	})
+	go periodic.Run(ctx, "volume_status", cliCtx.Duration(VolumeStatusIntervalFlag.Name), func(ctx context.Context) error {
+		return srv.SyncVolumeStatuses(server.SystemContext(ctx))
+	})
+	go periodic.Run(ctx, "webhook_delivery", cliCtx.Duration(WebhookDeliveryIntervalFlag.Name), func(ctx context.Context) error {
+		return srv.DeliverWebhooks(server.SystemContext(ctx))
+	})
+	if cliCtx.Bool(VolumeWatchFlag.Name) {
+		go srv.WatchVolumeStatuses(server.SystemContext(ctx))
+	}
+}
 
 var version string
 
@@ -72,74 +154,74 @@ func main() {
 			&DBBaseFlag,
 			&DBSSLModeFlag,
 			&ListenAddrFlag,
+			&GRPCListenAddrFlag,
 			&BillingAddrFlag,
+			&BillingFakeDataFlag,
 			&KubeAPIAddrFlag,
+			&KubeClusterFlag,
+			&KubeClustersConfigFlag,
+			&KubeBackendFlag,
+			&KubeHostFlag,
+			&KubeTokenFileFlag,
+			&KubeCAFileFlag,
+			&KubeInsecureFlag,
+			&ClientTimeoutFlag,
+			&ClientRetriesFlag,
+			&ClientRetryWaitFlag,
+			&ClientRetryMaxWaitFlag,
+			&ClientBreakerThresholdFlag,
+			&ClientBreakerTimeoutFlag,
+			&ClientDebugFlag,
+			&TariffCacheTTLFlag,
+			&TariffCacheSizeFlag,
 			&CORSFlag,
+			&StorageClassesAllowFlag,
+			&StorageSyncIntervalFlag,
+			&UsageSnapshotIntervalFlag,
+			&BillingReconcileIntervalFlag,
+			&BillingReconcileFixFlag,
+			&CapacityAlertThresholdsFlag,
+			&CapacityAlertWebhookFlag,
+			&CapacityCheckIntervalFlag,
+			&VolumeStatusIntervalFlag,
+			&VolumeWatchFlag,
+			&WebhookDeliveryIntervalFlag,
+			&WebhookTimeoutFlag,
+			&WebhookNearFullThresholdsFlag,
 		},
 		Before: func(ctx *cli.Context) error {
 			prettyPrintFlags(ctx)
 
-			if err := setupLogger(ctx); err != nil {
-				return err
-			}
-
-			listenAddr := getListenAddr(ctx)
-
-			translate := setupTranslator()
-			validate := validation.StandardPermissionsValidator(translate)
-
-			db, err := setupDB(ctx)
+			return setupLogger(ctx)
+		},
+		Commands: []*cli.Command{
+			&RecalculateStoragesCommand,
+		},
+		Action: func(ctx *cli.Context) error {
+			srv, err := setupServer(ctx)
 			if err != nil {
 				return err
 			}
 
-			clients, err := setupServiceClients(ctx)
+			httpsrv := setupHTTPServer(ctx, srv)
+			grpcsrv, grpcListener, err := setupGRPCServer(ctx, srv)
 			if err != nil {
 				return err
 			}
 
-			srv := server.NewServer(db, clients)
-
-			g := gin.New()
-			g.Use(gonic.Recovery(errors.ErrInternal, cherrylog.NewLogrusAdapter(logrus.WithField("component", "gin_recovery"))))
-			g.Use(ginrus.Ginrus(logrus.StandardLogger(), time.RFC3339, true))
-			binding.Validator = &validation.GinValidatorV9{Validate: validate} // gin has no local validator
-
-			if ctx.Bool(CORSFlag.Name) {
-				corsCfg := cors.DefaultConfig()
-				corsCfg.AllowAllOrigins = true
-				corsCfg.AddAllowHeaders(
-					httputil.UserIDXHeader,
-					httputil.UserRoleXHeader,
-				)
-				g.Use(cors.New(corsCfg))
-			}
-
-			status := model.ServiceStatus{
-				Name:     ctx.App.Name,
-				Version:  ctx.App.Version,
-				StatusOK: true,
-			}
+			jobsCtx, stopJobs := context.WithCancel(context.Background())
+			defer stopJobs()
+			runJobs(jobsCtx, ctx, srv)
 
-			r := router.NewRouter(g, &status, &router.TranslateValidate{UniversalTranslator: translate, Validate: validate})
-			r.SetupVolumeHandlers(srv)
-			r.SetupStorageHandlers(srv)
-
-			// for graceful shutdown
-			httpsrv := &http.Server{
-				Addr:    listenAddr,
-				Handler: g,
-			}
-
-			ctx.App.Metadata[httpServerContextKey] = httpsrv
-
-			return nil
-		},
-		Action: func(ctx *cli.Context) error {
-			httpsrv := ctx.App.Metadata[httpServerContextKey].(*http.Server)
 			errCh := errFuture(func() error {
 				return httpsrv.ListenAndServe()
 			})
+			var grpcErrCh <-chan error // nil channel never fires if gRPC API is disabled
+			if grpcsrv != nil {
+				grpcErrCh = errFuture(func() error {
+					return grpcsrv.Serve(grpcListener)
+				})
+			}
 
 			// Wait for interrupt signal to gracefully shutdown the server with
 			// a timeout of 5 seconds.
@@ -149,11 +231,18 @@ func main() {
 			select {
 			case err := <-errCh:
 				return err
+			case err := <-grpcErrCh:
+				return err
 			case <-quit:
 				logrus.Infoln("shutting down server...")
 				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 				defer cancel()
-				return httpsrv.Shutdown(ctx)
+				err := httpsrv.Shutdown(ctx)
+				if grpcsrv != nil {
+					grpcsrv.GracefulStop()
+				}
+				srv.Wait()
+				return err
 			}
 		},
 	}
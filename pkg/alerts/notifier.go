@@ -0,0 +1,114 @@
+// Package alerts contains sinks for capacity alerts.
+package alerts
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sync"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/json-iterator/go"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/resty.v1"
+)
+
+// Notifier delivers capacity alerts
+type Notifier interface {
+	Notify(ctx context.Context, alert model.CapacityAlert) error
+}
+
+// LogNotifier writes alerts to log
+type LogNotifier struct {
+	log *logrus.Entry
+}
+
+func NewLogNotifier() *LogNotifier {
+	return &LogNotifier{log: logrus.WithField("component", "capacity_alerts")}
+}
+
+func (n *LogNotifier) Notify(ctx context.Context, alert model.CapacityAlert) error {
+	n.log.WithFields(logrus.Fields{
+		"kind":      alert.Kind,
+		"name":      alert.Name,
+		"threshold": alert.Threshold,
+		"usage":     alert.Usage,
+		"used":      alert.Used.String(),
+		"limit":     alert.Limit.String(),
+	}).Warnf("capacity threshold reached")
+	return nil
+}
+
+// WebhookNotifier posts alerts as JSON to provided URL
+type WebhookNotifier struct {
+	client *resty.Client
+	url    string
+}
+
+func NewWebhookNotifier(u *url.URL) *WebhookNotifier {
+	client := resty.New().
+		SetHeader("Content-Type", "application/json")
+	client.JSONMarshal = jsoniter.Marshal
+	return &WebhookNotifier{
+		client: client,
+		url:    u.String(),
+	}
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, alert model.CapacityAlert) error {
+	resp, err := n.client.R().
+		SetContext(ctx).
+		SetBody(alert).
+		Post(n.url)
+	if err != nil {
+		return err
+	}
+	if resp.IsError() {
+		return fmt.Errorf("alert webhook responded with %s", resp.Status())
+	}
+	return nil
+}
+
+func (n *WebhookNotifier) String() string {
+	return fmt.Sprintf("capacity alerts webhook: %s", n.url)
+}
+
+// MemoryNotifier keeps alerts in memory, useful for tests
+type MemoryNotifier struct {
+	mu     sync.Mutex
+	alerts []model.CapacityAlert
+}
+
+func NewMemoryNotifier() *MemoryNotifier {
+	return &MemoryNotifier{}
+}
+
+func (n *MemoryNotifier) Notify(ctx context.Context, alert model.CapacityAlert) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.alerts = append(n.alerts, alert)
+	return nil
+}
+
+// Alerts returns received alerts
+func (n *MemoryNotifier) Alerts() []model.CapacityAlert {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]model.CapacityAlert(nil), n.alerts...)
+}
+
+// MultiNotifier delivers alerts to all notifiers
+type MultiNotifier []Notifier
+
+func (n MultiNotifier) Notify(ctx context.Context, alert model.CapacityAlert) error {
+	var errs []error
+	for _, notifier := range n {
+		if err := notifier.Notify(ctx, alert); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("alert notify errors: %v", errs)
+	}
+	return nil
+}
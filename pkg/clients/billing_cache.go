@@ -0,0 +1,194 @@
+package clients
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	volModel "git.containerum.net/ch/volume-manager/pkg/models"
+	btypes "github.com/containerum/bill-external/models"
+)
+
+// TariffCacheConfig configures tariff cache of billing client
+type TariffCacheConfig struct {
+	// Time after which cached tariff is requested from billing again
+	TTL time.Duration
+
+	// Maximum number of cached tariffs, least recently used ones are evicted first
+	Size int
+}
+
+// DefaultTariffCacheConfig is used when tariff cache enabled without explicit configuration
+var DefaultTariffCacheConfig = TariffCacheConfig{
+	TTL:  5 * time.Minute,
+	Size: 1000,
+}
+
+const (
+	volumeTariffKeyPrefix    = "volume:"
+	namespaceTariffKeyPrefix = "namespace:"
+)
+
+type tariffCacheEntry struct {
+	key     string
+	value   interface{}
+	expires time.Time
+}
+
+// BillingCachedClient caches volume and namespace tariffs of underlying billing client.
+// Other calls are passed through. Errors are not cached.
+type BillingCachedClient struct {
+	BillingClient
+
+	cfg TariffCacheConfig
+	now func() time.Time
+
+	mu        sync.Mutex
+	entries   map[string]*list.Element
+	order     *list.List // front is most recently used
+	hits      uint64
+	misses    uint64
+	evictions uint64
+}
+
+func NewBillingCachedClient(client BillingClient, cfg TariffCacheConfig) *BillingCachedClient {
+	return &BillingCachedClient{
+		BillingClient: client,
+		cfg:           cfg,
+		now:           time.Now,
+		entries:       make(map[string]*list.Element),
+		order:         list.New(),
+	}
+}
+
+func (c *BillingCachedClient) get(key string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if ok && c.now().Before(elem.Value.(*tariffCacheEntry).expires) {
+		c.hits++
+		c.order.MoveToFront(elem)
+		return elem.Value.(*tariffCacheEntry).value, true
+	}
+	if ok {
+		c.remove(elem)
+	}
+	c.misses++
+	return nil, false
+}
+
+func (c *BillingCachedClient) put(key string, value interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &tariffCacheEntry{key: key, value: value, expires: c.now().Add(c.cfg.TTL)}
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.order.MoveToFront(elem)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	for c.order.Len() > c.cfg.Size {
+		c.remove(c.order.Back())
+		c.evictions++
+	}
+}
+
+func (c *BillingCachedClient) remove(elem *list.Element) {
+	c.order.Remove(elem)
+	delete(c.entries, elem.Value.(*tariffCacheEntry).key)
+}
+
+func (c *BillingCachedClient) GetVolumeTariff(ctx context.Context, tariffID string) (btypes.VolumeTariff, error) {
+	key := volumeTariffKeyPrefix + tariffID
+	if cached, ok := c.get(key); ok {
+		return cached.(btypes.VolumeTariff), nil
+	}
+
+	tariff, err := c.BillingClient.GetVolumeTariff(ctx, tariffID)
+	if err != nil {
+		return tariff, err
+	}
+	c.put(key, tariff)
+	return tariff, nil
+}
+
+func (c *BillingCachedClient) GetTariffForNamespace(ctx context.Context, nsID string) (btypes.NamespaceTariff, error) {
+	key := namespaceTariffKeyPrefix + nsID
+	if cached, ok := c.get(key); ok {
+		return cached.(btypes.NamespaceTariff), nil
+	}
+
+	tariff, err := c.BillingClient.GetTariffForNamespace(ctx, nsID)
+	if err != nil {
+		return tariff, err
+	}
+	c.put(key, tariff)
+	return tariff, nil
+}
+
+// InvalidateTariffs removes cached volume tariffs and namespace tariffs with given ids.
+// If no ids provided, whole cache is purged.
+func (c *BillingCachedClient) InvalidateTariffs(tariffIDs, nsIDs []string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(tariffIDs) == 0 && len(nsIDs) == 0 {
+		c.entries = make(map[string]*list.Element)
+		c.order.Init()
+		return
+	}
+
+	var keys []string
+	for _, tariffID := range tariffIDs {
+		keys = append(keys, volumeTariffKeyPrefix+tariffID)
+	}
+	for _, nsID := range nsIDs {
+		keys = append(keys, namespaceTariffKeyPrefix+nsID)
+	}
+	for _, key := range keys {
+		if elem, ok := c.entries[key]; ok {
+			c.remove(elem)
+		}
+	}
+}
+
+// TariffCacheStats returns cache size and hit/miss counters
+func (c *BillingCachedClient) TariffCacheStats() volModel.TariffCacheStats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	stats := volModel.TariffCacheStats{
+		Enabled:   true,
+		TTL:       c.cfg.TTL.String(),
+		Size:      c.order.Len(),
+		MaxSize:   c.cfg.Size,
+		Hits:      c.hits,
+		Misses:    c.misses,
+		Evictions: c.evictions,
+	}
+	if total := c.hits + c.misses; total > 0 {
+		stats.HitRatio = float64(c.hits) / float64(total)
+	}
+	return stats
+}
+
+// Unwrap returns underlying billing client
+func (c *BillingCachedClient) Unwrap() BillingClient {
+	return c.BillingClient
+}
+
+// BreakerState returns state of underlying client circuit breaker
+func (c *BillingCachedClient) BreakerState() BreakerState {
+	if reporter, ok := c.BillingClient.(interface{ BreakerState() BreakerState }); ok {
+		return reporter.BreakerState()
+	}
+	return BreakerClosed
+}
+
+func (c *BillingCachedClient) String() string {
+	return fmt.Sprintf("%v with tariff cache: ttl=%s, size=%d", c.BillingClient, c.cfg.TTL, c.cfg.Size)
+}
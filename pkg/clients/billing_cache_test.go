@@ -0,0 +1,64 @@
+package clients
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	btypes "github.com/containerum/bill-external/models"
+)
+
+type countingBilling struct {
+	*BillingDummyClient
+	calls int
+}
+
+func (b *countingBilling) GetTariffForNamespace(ctx context.Context, nsID string) (btypes.NamespaceTariff, error) {
+	b.calls++
+	return b.BillingDummyClient.GetTariffForNamespace(ctx, nsID)
+}
+
+func TestBillingCachedClient(t *testing.T) {
+	now := time.Now()
+	billing := &countingBilling{BillingDummyClient: NewBillingDummyClient()}
+	cache := NewBillingCachedClient(billing, TariffCacheConfig{TTL: time.Minute, Size: 2})
+	cache.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	steps := []struct {
+		nsID    string
+		advance time.Duration
+		calls   int
+	}{
+		{nsID: "ns1", calls: 1},
+		{nsID: "ns1", calls: 1},
+		{nsID: "ns2", calls: 2},
+		{nsID: "ns3", calls: 3}, // evicts ns1
+		{nsID: "ns1", calls: 4},
+		{nsID: "ns3", calls: 4},
+		{nsID: "ns3", advance: time.Minute, calls: 5}, // expired
+	}
+
+	for i, step := range steps {
+		now = now.Add(step.advance)
+		if _, err := cache.GetTariffForNamespace(ctx, step.nsID); err != nil {
+			t.Fatal(err)
+		}
+		if billing.calls != step.calls {
+			t.Fatalf("step %d: expected %d billing calls, got %d", i, step.calls, billing.calls)
+		}
+	}
+
+	stats := cache.TariffCacheStats()
+	if stats.Hits != 2 || stats.Misses != 5 || stats.Evictions != 2 || stats.Size != 2 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	cache.InvalidateTariffs(nil, []string{"ns3"})
+	if _, err := cache.GetTariffForNamespace(ctx, "ns3"); err != nil {
+		t.Fatal(err)
+	}
+	if billing.calls != 6 {
+		t.Errorf("expected invalidated tariff to be requested again")
+	}
+}
@@ -0,0 +1,384 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	volModel "git.containerum.net/ch/volume-manager/pkg/models"
+	berrors "github.com/containerum/bill-external/errors"
+	btypes "github.com/containerum/bill-external/models"
+	"github.com/containerum/cherry/adaptors/cherrylog"
+	"github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+// BillingFakeData describes tariffs and subscriptions known to dummy billing client
+type BillingFakeData struct {
+	VolumeTariffs []btypes.VolumeTariff `json:"volume_tariffs"`
+
+	// Namespace tariffs by namespace id
+	NamespaceTariffs map[string]btypes.NamespaceTariff `json:"namespace_tariffs"`
+
+	// Used for namespaces not listed in NamespaceTariffs
+	DefaultNamespaceTariff *btypes.NamespaceTariff `json:"default_namespace_tariff"`
+
+	// Initial subscriptions
+	Subscriptions []volModel.BillingSubscription `json:"subscriptions"`
+}
+
+// Data for dummy client
+
+var fakeData = `
+volume_tariffs:
+  - id: 15348470-e98f-4da0-8d2e-8c65e15d6eeb
+    created_at: 2017-12-27T07:55:22Z
+    storage_limit: 1
+    replicas_limit: 2
+    is_persistent: false
+    is_active: true
+    is_public: true
+    price: 0
+  - id: 11a35f90-c343-4fc1-a966-381f75568036
+    created_at: 2017-12-27T07:55:22Z
+    storage_limit: 2
+    replicas_limit: 2
+    is_persistent: false
+    is_active: true
+    is_public: true
+    price: 0
+default_namespace_tariff:
+  id: 25d1d873-53ef-493f-9253-28f2f5ab5095
+  label: fake-ns-tariff
+  is_active: true
+  is_public: true
+  cpu_limit: 10
+  memory_limit: 1024
+  traffic: 1000
+  traffic_price: 0.2
+  external_services: 10
+  internal_services: 10
+  volume_size: 10
+`
+
+// ParseBillingFakeData parses fake billing data in YAML or JSON format.
+// Field names are the same as in billing JSON API.
+func ParseBillingFakeData(data []byte) (BillingFakeData, error) {
+	var raw interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return BillingFakeData{}, err
+	}
+	// yaml decodes maps with interface{} keys which can not be encoded to JSON
+	jsonData, err := json.Marshal(yamlToJSON(raw))
+	if err != nil {
+		return BillingFakeData{}, err
+	}
+	var ret BillingFakeData
+	if err := json.Unmarshal(jsonData, &ret); err != nil {
+		return BillingFakeData{}, err
+	}
+	return ret, nil
+}
+
+func yamlToJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		ret := make(map[string]interface{}, len(v))
+		for key, value := range v {
+			ret[fmt.Sprint(key)] = yamlToJSON(value)
+		}
+		return ret
+	case []interface{}:
+		for i := range v {
+			v[i] = yamlToJSON(v[i])
+		}
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339Nano)
+	default:
+		return v
+	}
+}
+
+// LoadBillingFakeData reads fake billing data from YAML or JSON file
+func LoadBillingFakeData(path string) (BillingFakeData, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return BillingFakeData{}, err
+	}
+	ret, err := ParseBillingFakeData(data)
+	if err != nil {
+		return BillingFakeData{}, fmt.Errorf("%s: %v", filepath.Base(path), err)
+	}
+	return ret, nil
+}
+
+// BillingDummyClient is an in-process billing stand-in for development.
+// It serves tariffs from fake data, keeps subscriptions in memory and can simulate failures.
+type BillingDummyClient struct {
+	log    *cherrylog.LogrusAdapter
+	source string
+
+	mu            sync.Mutex
+	data          BillingFakeData
+	subscriptions map[string]volModel.BillingSubscription
+	failures      []volModel.BillingFailure
+}
+
+// NewBillingDummyClient creates a dummy billing service client with built-in tariffs.
+func NewBillingDummyClient() *BillingDummyClient {
+	data, err := ParseBillingFakeData([]byte(fakeData))
+	if err != nil {
+		panic(err)
+	}
+	return NewBillingDummyClientWithData(data, "built-in data")
+}
+
+// NewBillingDummyClientWithData creates a dummy billing service client serving provided data.
+func NewBillingDummyClientWithData(data BillingFakeData, source string) *BillingDummyClient {
+	b := &BillingDummyClient{
+		log:           cherrylog.NewLogrusAdapter(logrus.WithField("component", "billing_dummy")),
+		source:        source,
+		data:          data,
+		subscriptions: make(map[string]volModel.BillingSubscription),
+	}
+	for _, sub := range data.Subscriptions {
+		b.subscriptions[sub.ResourceID] = sub
+	}
+	return b
+}
+
+// fail returns simulated error if one configured for method
+func (b *BillingDummyClient) fail(method string) error {
+	for i, failure := range b.failures {
+		if failure.Method != "" && failure.Method != method {
+			continue
+		}
+		if failure.Times > 0 {
+			if failure.Times == 1 {
+				b.failures = append(b.failures[:i], b.failures[i+1:]...)
+			} else {
+				b.failures[i].Times--
+			}
+		}
+		err := errors.ErrServiceUnavailable().AddDetailF("simulated %s failure", method)
+		if failure.Status != 0 {
+			err.StatusHTTP = failure.Status
+		}
+		return err
+	}
+	return nil
+}
+
+func (b *BillingDummyClient) volumeTariff(tariffID string) (btypes.VolumeTariff, bool) {
+	for _, volumeTariff := range b.data.VolumeTariffs {
+		if volumeTariff.ID != "" && volumeTariff.ID == tariffID {
+			return volumeTariff, true
+		}
+	}
+	return btypes.VolumeTariff{}, false
+}
+
+func (b *BillingDummyClient) Subscribe(ctx context.Context, req btypes.SubscribeTariffRequest) error {
+	b.log.WithFields(logrus.Fields{
+		"tariff_id":   req.TariffID,
+		"resource_id": req.ResourceID,
+		"kind":        req.ResourceType,
+	}).Debugln("subscribing")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("Subscribe"); err != nil {
+		return err
+	}
+
+	if req.ResourceType == btypes.Volume {
+		tariff, ok := b.volumeTariff(req.TariffID)
+		if !ok {
+			return berrors.ErrNotFound().AddDetailF("volume tariff %s not exists", req.TariffID)
+		}
+		if !tariff.Active {
+			return berrors.ErrPermissionDenied().AddDetailF("tariff %s is not active", req.TariffID)
+		}
+	}
+	if _, ok := b.subscriptions[req.ResourceID]; ok {
+		return berrors.ErrConflict().AddDetailF("resource %s already subscribed", req.ResourceID)
+	}
+
+	now := time.Now().UTC()
+	b.subscriptions[req.ResourceID] = volModel.BillingSubscription{
+		ResourceID:    req.ResourceID,
+		ResourceLabel: req.ResourceLabel,
+		ResourceType:  req.ResourceType,
+		TariffID:      req.TariffID,
+		CreatedAt:     &now,
+	}
+	return nil
+}
+
+func (b *BillingDummyClient) Rename(ctx context.Context, resourceID, newLabel string) error {
+	b.log.WithFields(logrus.Fields{
+		"resource_id": resourceID,
+		"new_label":   newLabel,
+	}).Debugln("Rename")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("Rename"); err != nil {
+		return err
+	}
+
+	// subscriptions are not persisted, so resources created before restart are not known
+	if sub, ok := b.subscriptions[resourceID]; ok {
+		sub.ResourceLabel = newLabel
+		b.subscriptions[resourceID] = sub
+	}
+	return nil
+}
+
+func (b *BillingDummyClient) ChangeTariff(ctx context.Context, resourceID, tariffID string) error {
+	b.log.WithFields(logrus.Fields{
+		"resource_id": resourceID,
+		"tariff_id":   tariffID,
+	}).Debugln("changing tariff")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("ChangeTariff"); err != nil {
+		return err
+	}
+
+	// subscriptions are not persisted, so resources created before restart are not known
+	sub, ok := b.subscriptions[resourceID]
+	if !ok {
+		return nil
+	}
+	if sub.ResourceType == btypes.Volume {
+		tariff, ok := b.volumeTariff(tariffID)
+		if !ok {
+			return berrors.ErrNotFound().AddDetailF("volume tariff %s not exists", tariffID)
+		}
+		if !tariff.Active {
+			return berrors.ErrPermissionDenied().AddDetailF("tariff %s is not active", tariffID)
+		}
+	}
+	sub.TariffID = tariffID
+	b.subscriptions[resourceID] = sub
+	return nil
+}
+
+func (b *BillingDummyClient) Unsubscribe(ctx context.Context, resourceID string) error {
+	b.log.WithFields(logrus.Fields{
+		"resource_id": resourceID,
+	}).Debugln("unsubscribing")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("Unsubscribe"); err != nil {
+		return err
+	}
+
+	delete(b.subscriptions, resourceID)
+	return nil
+}
+
+func (b *BillingDummyClient) MassiveUnsubscribe(ctx context.Context, resourceIDs []string) error {
+	b.log.WithField("resource_ids", resourceIDs).Debugln("massive unsubscribing")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("MassiveUnsubscribe"); err != nil {
+		return err
+	}
+
+	for _, resourceID := range resourceIDs {
+		delete(b.subscriptions, resourceID)
+	}
+	return nil
+}
+
+func (b *BillingDummyClient) GetVolumeTariff(ctx context.Context, tariffID string) (btypes.VolumeTariff, error) {
+	b.log.WithField("tariff_id", tariffID).Debugln("get volume tariff")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("GetVolumeTariff"); err != nil {
+		return btypes.VolumeTariff{}, err
+	}
+
+	if tariff, ok := b.volumeTariff(tariffID); ok {
+		return tariff, nil
+	}
+	return btypes.VolumeTariff{}, berrors.ErrNotFound().AddDetailF("volume tariff %s not exists", tariffID)
+}
+
+func (b *BillingDummyClient) GetTariffForNamespace(ctx context.Context, nsID string) (btypes.NamespaceTariff, error) {
+	b.log.WithField("ns_id", nsID).Debugf("get tariff for namespace")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("GetTariffForNamespace"); err != nil {
+		return btypes.NamespaceTariff{}, err
+	}
+
+	if tariff, ok := b.data.NamespaceTariffs[nsID]; ok {
+		return tariff, nil
+	}
+	if b.data.DefaultNamespaceTariff != nil {
+		return *b.data.DefaultNamespaceTariff, nil
+	}
+	return btypes.NamespaceTariff{}, berrors.ErrNotFound().AddDetailF("namespace %s has no tariff", nsID)
+}
+
+func (b *BillingDummyClient) ListSubscriptions(ctx context.Context, resourceType btypes.ResourceType) ([]volModel.BillingSubscription, error) {
+	b.log.WithField("resource_type", resourceType).Debugf("list subscriptions")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if err := b.fail("ListSubscriptions"); err != nil {
+		return nil, err
+	}
+
+	return b.listSubscriptions(resourceType), nil
+}
+
+func (b *BillingDummyClient) listSubscriptions(resourceType btypes.ResourceType) []volModel.BillingSubscription {
+	ret := make([]volModel.BillingSubscription, 0)
+	for _, sub := range b.subscriptions {
+		if resourceType == "" || sub.ResourceType == resourceType {
+			ret = append(ret, sub)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].ResourceID < ret[j].ResourceID })
+	return ret
+}
+
+// FakeBillingState returns current subscriptions and simulated failures
+func (b *BillingDummyClient) FakeBillingState() volModel.FakeBillingState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return volModel.FakeBillingState{
+		Source:        b.source,
+		Subscriptions: b.listSubscriptions(""),
+		Failures:      append([]volModel.BillingFailure{}, b.failures...),
+	}
+}
+
+// SetFailures replaces simulated failures. Failures are checked in order, first matching one is returned.
+func (b *BillingDummyClient) SetFailures(failures []volModel.BillingFailure) {
+	b.log.WithField("failures", failures).Infof("set simulated failures")
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.failures = append([]volModel.BillingFailure{}, failures...)
+}
+
+func (b *BillingDummyClient) String() string {
+	return fmt.Sprintf("billing service dummy client: %s", b.source)
+}
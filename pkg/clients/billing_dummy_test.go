@@ -0,0 +1,79 @@
+package clients
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	btypes "github.com/containerum/bill-external/models"
+	"github.com/containerum/cherry"
+)
+
+const testFakeData = `
+volume_tariffs:
+  - id: 9b3b3a86-5d3c-4d5f-9bb3-3ac5d4a60f34
+    label: small
+    price: 1.5
+    is_active: true
+    is_public: true
+    storage_limit: 1
+  - id: 2bb6a2a1-7a2c-4d21-b0e8-2a8d0bdc1a1e
+    label: archived
+    is_active: false
+    storage_limit: 5
+namespace_tariffs:
+  ns1:
+    label: ns1-tariff
+    volume_size: 3
+`
+
+func TestBillingDummyClient(t *testing.T) {
+	data, err := ParseBillingFakeData([]byte(testFakeData))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := NewBillingDummyClientWithData(data, "test")
+	ctx := context.Background()
+
+	tariff, err := b.GetVolumeTariff(ctx, "9b3b3a86-5d3c-4d5f-9bb3-3ac5d4a60f34")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tariff.Label != "small" || tariff.Price != 1.5 || tariff.StorageLimit != 1 || !tariff.Active {
+		t.Errorf("unexpected tariff %+v", tariff)
+	}
+	if nsTariff, err := b.GetTariffForNamespace(ctx, "ns1"); err != nil || nsTariff.VolumeSize != 3 {
+		t.Errorf("unexpected namespace tariff %+v, %v", nsTariff, err)
+	}
+	if _, err := b.GetTariffForNamespace(ctx, "ns2"); err == nil {
+		t.Errorf("expected error for namespace without tariff")
+	}
+
+	if err := b.Subscribe(ctx, btypes.SubscribeTariffRequest{
+		TariffID:     "2bb6a2a1-7a2c-4d21-b0e8-2a8d0bdc1a1e",
+		ResourceType: btypes.Volume,
+		ResourceID:   "vol1",
+	}); err == nil {
+		t.Errorf("expected subscription to inactive tariff to fail")
+	}
+	if err := b.Subscribe(ctx, btypes.SubscribeTariffRequest{
+		TariffID:     tariff.ID,
+		ResourceType: btypes.Volume,
+		ResourceID:   "vol1",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if state := b.FakeBillingState(); len(state.Subscriptions) != 1 || state.Subscriptions[0].TariffID != tariff.ID {
+		t.Errorf("unexpected subscriptions %+v", state.Subscriptions)
+	}
+
+	b.SetFailures([]model.BillingFailure{{Method: "GetVolumeTariff", Times: 1, Status: http.StatusBadGateway}})
+	_, err = b.GetVolumeTariff(ctx, tariff.ID)
+	if cherryErr, ok := err.(*cherry.Err); !ok || cherryErr.StatusHTTP != http.StatusBadGateway {
+		t.Errorf("expected simulated failure, got %v", err)
+	}
+	if _, err := b.GetVolumeTariff(ctx, tariff.ID); err != nil {
+		t.Errorf("expected failure to be simulated once, got %v", err)
+	}
+}
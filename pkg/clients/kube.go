@@ -2,9 +2,14 @@ package clients
 
 import (
 	"context"
+	"net/http"
 	"net/url"
+	"sort"
+	"sync"
+	"time"
 
 	"git.containerum.net/ch/volume-manager/pkg/errors"
+	volModel "git.containerum.net/ch/volume-manager/pkg/models"
 	"github.com/containerum/cherry"
 	"github.com/containerum/cherry/adaptors/cherrylog"
 	"github.com/containerum/kube-client/pkg/model"
@@ -15,48 +20,99 @@ import (
 )
 
 type KubeAPIClient interface {
-	CreateVolume(ctx context.Context, namespace string, volume *model.Volume) error
-	UpdateVolume(ctx context.Context, namespace string, volume *model.Volume) error
+	// CreateVolume and UpdateVolume provision volume.CapacityBytes or return validation error
+	// if backend can not provision exact capacity.
+	CreateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error
+	UpdateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error
 	DeleteVolume(ctx context.Context, namespace string, volumeName string) error
+	// GetVolume returns ErrResourceNotExists if volume is not found in kubernetes
+	GetVolume(ctx context.Context, namespace string, volumeName string) (volModel.KubeVolume, error)
+	ListVolumes(ctx context.Context, namespace string) ([]volModel.KubeVolume, error)
+
+	GetStorageClasses(ctx context.Context) ([]volModel.StorageClass, error)
+}
+
+// KubeVolumeWatcher is implemented by kube backends able to stream volume changes
+type KubeVolumeWatcher interface {
+	WatchVolumes(ctx context.Context, handle func(volume volModel.KubeVolume, deleted bool)) error
+}
+
+// KubeVolumeRenamer is implemented by kube backends able to rename volumes.
+// Kubernetes claim names are immutable, so backends talking to kubernetes directly do not implement it.
+type KubeVolumeRenamer interface {
+	RenameVolume(ctx context.Context, namespace, oldName, newName string) error
+}
+
+// KubeVolumeSuspender is implemented by kube backends able to detach volumes of suspended accounts.
+// Suspended volume keeps its data but is not mounted by workloads until it is resumed.
+type KubeVolumeSuspender interface {
+	SuspendVolume(ctx context.Context, namespace, volumeName string) error
+	ResumeVolume(ctx context.Context, namespace, volumeName string) error
+}
+
+// KubeCapacityValidator is implemented by kube backends which can not provision arbitrary capacity.
+// Capacity is validated before volume is stored, so database and billing are not changed for volumes backend rejects.
+type KubeCapacityValidator interface {
+	ValidateCapacity(capacity volModel.Quantity) error
+}
+
+// KubeFixedAccessModeBackend is implemented by kube backends which can not change access mode of existing volume.
+// Access mode changes of volumes placed in such backend are rejected before volume is stored.
+type KubeFixedAccessModeBackend interface {
+	FixedAccessMode() bool
+}
+
+// KubeEphemeralBackend is implemented by kube backends which do not keep volumes between restarts.
+// Volume absent in such backend is not necessarily missing, so volume statuses are not polled from it.
+type KubeEphemeralBackend interface {
+	Ephemeral() bool
 }
 
 type KubeAPIHTTPClient struct {
-	log    *cherrylog.LogrusAdapter
-	client *resty.Client
+	log       *cherrylog.LogrusAdapter
+	client    *resty.Client
+	transport *resilientTransport
 }
 
-func NewKubeAPIHTTPClient(url *url.URL) *KubeAPIHTTPClient {
+func NewKubeAPIHTTPClient(url *url.URL, cfg ResilienceConfig) *KubeAPIHTTPClient {
 	log := logrus.WithField("component", "kube_api_client")
+	transport := newResilientTransport("kube-api", cfg, log)
 
 	client := resty.New().
 		SetLogger(log.WriterLevel(logrus.DebugLevel)).
 		SetHostURL(url.String()).
-		SetDebug(true).
+		SetTransport(transport).
+		SetDebug(cfg.Debug).
 		SetError(cherry.Err{}).
 		SetHeader("Content-Type", "application/json").
 		SetHeader("Accept", "application/json")
 	client.JSONMarshal = jsoniter.Marshal
 	client.JSONUnmarshal = jsoniter.Unmarshal
 	return &KubeAPIHTTPClient{
-		log:    cherrylog.NewLogrusAdapter(log),
-		client: client,
+		log:       cherrylog.NewLogrusAdapter(log),
+		client:    client,
+		transport: transport,
 	}
 }
 
-func (k *KubeAPIHTTPClient) CreateVolume(ctx context.Context, namespace string, volume *model.Volume) error {
+func (k *KubeAPIHTTPClient) CreateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error {
 	k.log.WithField("namespace", namespace).Debugf("create volume %+v", volume)
 
+	if err := k.ValidateCapacity(volModel.Quantity(volume.CapacityBytes)); err != nil {
+		return err
+	}
+
 	resp, err := k.client.R().
 		SetContext(ctx).
-		SetBody(*volume).
+		SetBody(volume.Volume).
 		SetHeaders(httputil.RequestXHeadersMap(ctx)).
 		SetPathParams(map[string]string{
 			"namespace": namespace,
 		}).
-		SetResult(volume).
+		SetResult(&volume.Volume).
 		Post("/namespaces/{namespace}/volumes")
 	if err != nil {
-		return errors.ErrInternal().Log(err, k.log)
+		return k.requestError(err)
 	}
 	if resp.Error() != nil {
 		return resp.Error().(*cherry.Err)
@@ -64,21 +120,25 @@ func (k *KubeAPIHTTPClient) CreateVolume(ctx context.Context, namespace string,
 	return nil
 }
 
-func (k *KubeAPIHTTPClient) UpdateVolume(ctx context.Context, namespace string, volume *model.Volume) error {
+func (k *KubeAPIHTTPClient) UpdateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error {
 	k.log.WithField("namespace", namespace).Debugf("update volume %+v", volume)
 
+	if err := k.ValidateCapacity(volModel.Quantity(volume.CapacityBytes)); err != nil {
+		return err
+	}
+
 	resp, err := k.client.R().
 		SetContext(ctx).
-		SetBody(*volume).
+		SetBody(volume.Volume).
 		SetHeaders(httputil.RequestXHeadersMap(ctx)).
 		SetPathParams(map[string]string{
 			"namespace": namespace,
 			"volume":    volume.Name,
 		}).
-		SetResult(volume).
+		SetResult(&volume.Volume).
 		Put("/namespaces/{namespace}/volumes/{volume}")
 	if err != nil {
-		return errors.ErrInternal().Log(err, k.log)
+		return k.requestError(err)
 	}
 	if resp.Error() != nil {
 		return resp.Error().(*cherry.Err)
@@ -98,7 +158,7 @@ func (k *KubeAPIHTTPClient) DeleteVolume(ctx context.Context, namespace string,
 		}).
 		Delete("/namespaces/{namespace}/volumes/{volume}")
 	if err != nil {
-		return errors.ErrInternal().Log(err, k.log)
+		return k.requestError(err)
 	}
 	if resp.Error() != nil {
 		return resp.Error().(*cherry.Err)
@@ -106,30 +166,239 @@ func (k *KubeAPIHTTPClient) DeleteVolume(ctx context.Context, namespace string,
 	return nil
 }
 
+func (k *KubeAPIHTTPClient) GetVolume(ctx context.Context, namespace string, volumeName string) (volModel.KubeVolume, error) {
+	k.log.WithField("namespace", namespace).Debugf("get volume %s", volumeName)
+
+	resp, err := k.client.R().
+		SetContext(ctx).
+		SetHeaders(httputil.RequestXHeadersMap(ctx)).
+		SetPathParams(map[string]string{
+			"namespace": namespace,
+			"volume":    volumeName,
+		}).
+		SetResult(model.Volume{}).
+		Get("/namespaces/{namespace}/volumes/{volume}")
+	if err != nil {
+		return volModel.KubeVolume{}, k.requestError(err)
+	}
+	// kube-api error has own service ID, so it is replaced to be comparable with local errors
+	if resp.StatusCode() == http.StatusNotFound {
+		return volModel.KubeVolume{}, errors.ErrResourceNotExists().AddDetailF("volume %s not found in namespace %s", volumeName, namespace)
+	}
+	if resp.Error() != nil {
+		return volModel.KubeVolume{}, resp.Error().(*cherry.Err)
+	}
+	return volModel.KubeVolume{Volume: *resp.Result().(*model.Volume)}, nil
+}
+
+func (k *KubeAPIHTTPClient) ListVolumes(ctx context.Context, namespace string) ([]volModel.KubeVolume, error) {
+	k.log.WithField("namespace", namespace).Debugf("list volumes")
+
+	resp, err := k.client.R().
+		SetContext(ctx).
+		SetHeaders(httputil.RequestXHeadersMap(ctx)).
+		SetPathParams(map[string]string{
+			"namespace": namespace,
+		}).
+		SetResult(model.VolumesList{}).
+		Get("/namespaces/{namespace}/volumes")
+	if err != nil {
+		return nil, k.requestError(err)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error().(*cherry.Err)
+	}
+	volumes := resp.Result().(*model.VolumesList).Volumes
+	ret := make([]volModel.KubeVolume, len(volumes))
+	for i := range volumes {
+		ret[i] = volModel.KubeVolume{Volume: volumes[i]}
+	}
+	return ret, nil
+}
+
+func (k *KubeAPIHTTPClient) GetStorageClasses(ctx context.Context) ([]volModel.StorageClass, error) {
+	k.log.Debugf("get storage classes")
+
+	resp, err := k.client.R().
+		SetContext(ctx).
+		SetHeaders(httputil.RequestXHeadersMap(ctx)).
+		SetResult(volModel.StorageClassesList{}).
+		Get("/storageclasses")
+	if err != nil {
+		return nil, k.requestError(err)
+	}
+	if resp.Error() != nil {
+		return nil, resp.Error().(*cherry.Err)
+	}
+	return resp.Result().(*volModel.StorageClassesList).StorageClasses, nil
+}
+
+// ValidateCapacity rejects capacities kube-api can not provision, it accepts capacity in whole GiB only
+func (k *KubeAPIHTTPClient) ValidateCapacity(capacity volModel.Quantity) error {
+	if capacity%volModel.GiB != 0 {
+		return errors.ErrRequestValidationFailed().
+			AddDetailF("kube-api backend provisions only whole GiB, capacity %s is not supported", capacity)
+	}
+	return nil
+}
+
+// requestError converts request error to cherry error
+func (k *KubeAPIHTTPClient) requestError(err error) error {
+	if cherryErr, ok := serviceError(err).(*cherry.Err); ok {
+		return cherryErr
+	}
+	return errors.ErrInternal().Log(err, k.log)
+}
+
+// BreakerState returns state of kube-api circuit breaker
+func (k *KubeAPIHTTPClient) BreakerState() BreakerState {
+	return k.transport.breaker.State()
+}
+
+// KubeAPIDummyClient keeps volumes in memory. Volumes are reported as bound right after creation.
 type KubeAPIDummyClient struct {
 	log *logrus.Entry
+
+	mu        sync.Mutex
+	volumes   map[string]map[string]volModel.KubeVolume // by namespace and name
+	suspended map[string]bool                           // by namespace/name
 }
 
 func NewKubeAPIDummyClient() *KubeAPIDummyClient {
 	return &KubeAPIDummyClient{
-		log: logrus.WithField("component", "kube_api_client"),
+		log:       logrus.WithField("component", "kube_api_client"),
+		volumes:   make(map[string]map[string]volModel.KubeVolume),
+		suspended: make(map[string]bool),
 	}
 }
 
-func (k *KubeAPIDummyClient) CreateVolume(ctx context.Context, namespace string, volume *model.Volume) error {
+// Ephemeral reports that volumes created before process start are unknown to dummy client
+func (k *KubeAPIDummyClient) Ephemeral() bool {
+	return true
+}
+
+func (k *KubeAPIDummyClient) CreateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error {
 	k.log.WithField("namespace", namespace).Debugf("create volume %+v", volume)
 
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	if _, exists := k.volumes[namespace][volume.Name]; exists {
+		return errors.ErrResourceAlreadyExists().AddDetailF("volume %s already exists", volume.Name)
+	}
+	k.store(namespace, *volume)
 	return nil
 }
 
-func (k *KubeAPIDummyClient) UpdateVolume(ctx context.Context, namespace string, volume *model.Volume) error {
+// UpdateVolume stores volume even if it is not known, volumes are not persisted between restarts
+func (k *KubeAPIDummyClient) UpdateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error {
 	k.log.WithField("namespace", namespace).Debugf("update volume %+v", volume)
 
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.store(namespace, *volume)
 	return nil
 }
 
+func (k *KubeAPIDummyClient) store(namespace string, volume volModel.KubeVolume) {
+	if k.volumes[namespace] == nil {
+		k.volumes[namespace] = make(map[string]volModel.KubeVolume)
+	}
+	if old, exists := k.volumes[namespace][volume.Name]; exists {
+		volume.CreatedAt = old.CreatedAt
+	} else if volume.CreatedAt == "" {
+		volume.CreatedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	volume.Namespace = namespace
+	volume.Status = "Bound"
+	k.volumes[namespace][volume.Name] = volume
+}
+
 func (k *KubeAPIDummyClient) DeleteVolume(ctx context.Context, namespace string, volumeName string) error {
 	k.log.WithField("namespace", namespace).Debugf("delete volume %s", volumeName)
 
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.volumes[namespace], volumeName)
+	delete(k.suspended, namespace+"/"+volumeName)
 	return nil
 }
+
+func (k *KubeAPIDummyClient) RenameVolume(ctx context.Context, namespace, oldName, newName string) error {
+	k.log.WithField("namespace", namespace).Debugf("rename volume %s to %s", oldName, newName)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	volume, exists := k.volumes[namespace][oldName]
+	if !exists {
+		return errors.ErrResourceNotExists().AddDetailF("volume %s not exists", oldName)
+	}
+	if _, exists := k.volumes[namespace][newName]; exists {
+		return errors.ErrResourceAlreadyExists().AddDetailF("volume %s already exists", newName)
+	}
+	delete(k.volumes[namespace], oldName)
+	volume.Name = newName
+	k.volumes[namespace][newName] = volume
+	if k.suspended[namespace+"/"+oldName] {
+		delete(k.suspended, namespace+"/"+oldName)
+		k.suspended[namespace+"/"+newName] = true
+	}
+	return nil
+}
+
+// SuspendVolume only remembers volume is suspended, there are no workloads to detach it from
+func (k *KubeAPIDummyClient) SuspendVolume(ctx context.Context, namespace, volumeName string) error {
+	k.log.WithField("namespace", namespace).Debugf("suspend volume %s", volumeName)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.suspended[namespace+"/"+volumeName] = true
+	return nil
+}
+
+func (k *KubeAPIDummyClient) ResumeVolume(ctx context.Context, namespace, volumeName string) error {
+	k.log.WithField("namespace", namespace).Debugf("resume volume %s", volumeName)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	delete(k.suspended, namespace+"/"+volumeName)
+	return nil
+}
+
+func (k *KubeAPIDummyClient) GetVolume(ctx context.Context, namespace string, volumeName string) (volModel.KubeVolume, error) {
+	k.log.WithField("namespace", namespace).Debugf("get volume %s", volumeName)
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	volume, exists := k.volumes[namespace][volumeName]
+	if !exists {
+		return volModel.KubeVolume{}, errors.ErrResourceNotExists().AddDetailF("volume %s not exists", volumeName)
+	}
+	return volume, nil
+}
+
+func (k *KubeAPIDummyClient) ListVolumes(ctx context.Context, namespace string) ([]volModel.KubeVolume, error) {
+	k.log.WithField("namespace", namespace).Debugf("list volumes")
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	ret := make([]volModel.KubeVolume, 0, len(k.volumes[namespace]))
+	for _, volume := range k.volumes[namespace] {
+		ret = append(ret, volume)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret, nil
+}
+
+func (k *KubeAPIDummyClient) GetStorageClasses(ctx context.Context) ([]volModel.StorageClass, error) {
+	k.log.Debugf("get storage classes")
+
+	return []volModel.StorageClass{}, nil
+}
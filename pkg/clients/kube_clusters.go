@@ -0,0 +1,134 @@
+package clients
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// KubeCluster is a named kube backend owning storages
+type KubeCluster struct {
+	Name   string
+	Client KubeAPIClient
+
+	// Backend name used in messages about operations backend does not support, e.g. kube-api
+	Backend string
+
+	// Storages placed in cluster. Storages not listed in any cluster are placed in default cluster.
+	Storages []string
+}
+
+// KubeClusters routes volumes to kube backends of clusters owning their storages
+type KubeClusters struct {
+	defaultCluster string
+	clusters       map[string]KubeCluster
+	byStorage      map[string]string
+}
+
+// NewKubeClusters creates clusters registry. Default cluster must be one of clusters.
+func NewKubeClusters(defaultCluster string, clusters ...KubeCluster) (*KubeClusters, error) {
+	ret := &KubeClusters{
+		defaultCluster: defaultCluster,
+		clusters:       make(map[string]KubeCluster, len(clusters)),
+		byStorage:      make(map[string]string),
+	}
+	for _, cluster := range clusters {
+		if cluster.Name == "" {
+			return nil, fmt.Errorf("cluster name is empty")
+		}
+		if _, exists := ret.clusters[cluster.Name]; exists {
+			return nil, fmt.Errorf("cluster %s registered twice", cluster.Name)
+		}
+		for _, storage := range cluster.Storages {
+			if owner, exists := ret.byStorage[storage]; exists {
+				return nil, fmt.Errorf("storage %s placed in clusters %s and %s", storage, owner, cluster.Name)
+			}
+			ret.byStorage[storage] = cluster.Name
+		}
+		ret.clusters[cluster.Name] = cluster
+	}
+	if _, exists := ret.clusters[defaultCluster]; !exists {
+		return nil, fmt.Errorf("default cluster %s is not registered", defaultCluster)
+	}
+	return ret, nil
+}
+
+// SingleKubeCluster creates registry with one cluster owning all storages
+func SingleKubeCluster(name string, client KubeAPIClient) *KubeClusters {
+	ret, _ := NewKubeClusters(name, KubeCluster{Name: name, Client: client})
+	return ret
+}
+
+// Default returns default cluster
+func (c *KubeClusters) Default() KubeCluster {
+	return c.clusters[c.defaultCluster]
+}
+
+// Cluster returns cluster by name. Empty name means default cluster.
+func (c *KubeClusters) Cluster(name string) (KubeCluster, bool) {
+	if name == "" {
+		return c.Default(), true
+	}
+	cluster, ok := c.clusters[name]
+	return cluster, ok
+}
+
+// StorageCluster returns cluster owning storage
+func (c *KubeClusters) StorageCluster(storage string) KubeCluster {
+	if name, ok := c.byStorage[storage]; ok {
+		return c.clusters[name]
+	}
+	return c.Default()
+}
+
+// Clusters returns all clusters sorted by name
+func (c *KubeClusters) Clusters() []KubeCluster {
+	ret := make([]KubeCluster, 0, len(c.clusters))
+	for _, cluster := range c.clusters {
+		ret = append(ret, cluster)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
+
+// BreakerStates returns circuit breaker states of clusters backends supporting it
+func (c *KubeClusters) BreakerStates() map[string]BreakerState {
+	ret := make(map[string]BreakerState)
+	for name, cluster := range c.clusters {
+		if reporter, ok := cluster.Client.(interface{ BreakerState() BreakerState }); ok {
+			ret[name] = reporter.BreakerState()
+		}
+	}
+	return ret
+}
+
+func (c *KubeClusters) Close() error {
+	var errs []string
+	for _, cluster := range c.clusters {
+		if closer, ok := cluster.Client.(io.Closer); ok {
+			if err := closer.Close(); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("close clusters: %s", strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (c *KubeClusters) String() string {
+	var ret []string
+	for _, cluster := range c.Clusters() {
+		str := fmt.Sprintf("cluster %s", cluster.Name)
+		if cluster.Name == c.defaultCluster {
+			str += " (default)"
+		}
+		if len(cluster.Storages) > 0 {
+			str += fmt.Sprintf(" storages %v", cluster.Storages)
+		}
+		ret = append(ret, fmt.Sprintf("%s: %v", str, cluster.Client))
+	}
+	return strings.Join(ret, "; ")
+}
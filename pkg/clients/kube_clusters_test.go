@@ -0,0 +1,37 @@
+package clients
+
+import "testing"
+
+func TestKubeClusters(t *testing.T) {
+	east, west := NewKubeAPIDummyClient(), NewKubeAPIDummyClient()
+	clusters, err := NewKubeClusters("east",
+		KubeCluster{Name: "east", Client: east},
+		KubeCluster{Name: "west", Client: west, Storages: []string{"ceph-west"}},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if cluster := clusters.StorageCluster("ceph-west"); cluster.Name != "west" || cluster.Client != west {
+		t.Errorf("expected storage to be placed in west cluster, got %s", cluster.Name)
+	}
+	if cluster := clusters.StorageCluster("ceph"); cluster.Name != "east" || cluster.Client != east {
+		t.Errorf("expected unmapped storage to be placed in default cluster, got %s", cluster.Name)
+	}
+	if cluster, ok := clusters.Cluster(""); !ok || cluster.Name != "east" {
+		t.Errorf("expected empty cluster name to mean default cluster, got %s", cluster.Name)
+	}
+	if _, ok := clusters.Cluster("north"); ok {
+		t.Errorf("expected unknown cluster not to be found")
+	}
+
+	if _, err := NewKubeClusters("east",
+		KubeCluster{Name: "east", Client: east, Storages: []string{"ceph"}},
+		KubeCluster{Name: "west", Client: west, Storages: []string{"ceph"}},
+	); err == nil {
+		t.Errorf("expected error for storage placed in two clusters")
+	}
+	if _, err := NewKubeClusters("north", KubeCluster{Name: "east", Client: east}); err == nil {
+		t.Errorf("expected error for unknown default cluster")
+	}
+}
@@ -0,0 +1,573 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	volModel "git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/containerum/cherry/adaptors/cherrylog"
+	"github.com/containerum/kube-client/pkg/model"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	kubeErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	serviceAccountDir = "/var/run/secrets/kubernetes.io/serviceaccount"
+
+	// StorageClassCapacityAnnotation may be set on storage class to provide capacity hint
+	StorageClassCapacityAnnotation = "volume-manager.containerum.io/capacity"
+
+	// SuspendedAnnotation is set on claims of suspended volumes
+	SuspendedAnnotation = "volume-manager.containerum.io/suspended"
+
+	// SuspendedReplicasAnnotation keeps replicas of workload scaled down because it mounts suspended volume
+	SuspendedReplicasAnnotation = "volume-manager.containerum.io/suspended-replicas"
+
+	tariffAnnotation = "volume-manager.containerum.io/tariff-id"
+	managedByLabel   = "app.kubernetes.io/managed-by"
+	managedByValue   = "volume-manager"
+)
+
+// KubeDirectConfig configures access to kubernetes API server
+type KubeDirectConfig struct {
+	// API server URL, e.g. https://10.0.0.1:443
+	Host string
+
+	// Bearer token or file with token. File is reread periodically, so rotated tokens are picked up.
+	Token     string
+	TokenFile string
+
+	// CA certificate of API server, system roots are used if empty
+	CAFile string
+
+	Insecure bool
+}
+
+// InClusterKubeDirectConfig returns configuration of service account volume-manager pod runs with
+func InClusterKubeDirectConfig() (KubeDirectConfig, error) {
+	host, port := os.Getenv("KUBERNETES_SERVICE_HOST"), os.Getenv("KUBERNETES_SERVICE_PORT")
+	if host == "" || port == "" {
+		return KubeDirectConfig{}, fmt.Errorf("not running in kubernetes cluster: KUBERNETES_SERVICE_HOST and KUBERNETES_SERVICE_PORT are not set")
+	}
+	return KubeDirectConfig{
+		Host:      "https://" + net.JoinHostPort(host, port),
+		TokenFile: serviceAccountDir + "/token",
+		CAFile:    serviceAccountDir + "/ca.crt",
+	}, nil
+}
+
+func (cfg KubeDirectConfig) restConfig() *rest.Config {
+	return &rest.Config{
+		Host:            cfg.Host,
+		BearerToken:     cfg.Token,
+		BearerTokenFile: cfg.TokenFile,
+		TLSClientConfig: rest.TLSClientConfig{
+			CAFile:   cfg.CAFile,
+			Insecure: cfg.Insecure,
+		},
+	}
+}
+
+// KubeDirectClient manages volumes as PersistentVolumeClaims through kubernetes API without kube-api service
+type KubeDirectClient struct {
+	log       *cherrylog.LogrusAdapter
+	client    kubernetes.Interface
+	transport *resilientTransport
+	host      string
+
+	// watch lasts longer than request timeout, so it is made by client without resilient transport
+	watchClient kubernetes.Interface
+}
+
+func NewKubeDirectClient(cfg KubeDirectConfig, rcfg ResilienceConfig) (*KubeDirectClient, error) {
+	transport := newResilientTransport("kubernetes", rcfg, logrus.WithField("component", "kube_direct_client"))
+	restCfg := cfg.restConfig()
+	restCfg.WrapTransport = func(rt http.RoundTripper) http.RoundTripper {
+		transport.next = rt
+		return transport
+	}
+	client, err := kubernetes.NewForConfig(restCfg)
+	if err != nil {
+		return nil, err
+	}
+	watchClient, err := kubernetes.NewForConfig(cfg.restConfig())
+	if err != nil {
+		return nil, err
+	}
+
+	ret := newKubeDirectClient(client, watchClient)
+	ret.transport = transport
+	ret.host = strings.TrimSuffix(cfg.Host, "/")
+	return ret, nil
+}
+
+func newKubeDirectClient(client, watchClient kubernetes.Interface) *KubeDirectClient {
+	return &KubeDirectClient{
+		log:         cherrylog.NewLogrusAdapter(logrus.WithField("component", "kube_direct_client")),
+		client:      client,
+		watchClient: watchClient,
+	}
+}
+
+// claimCapacity returns exact requested capacity, e.g. "512Mi". Volumes without byte capacity are requested in GiB.
+func claimCapacity(volume *volModel.KubeVolume) resource.Quantity {
+	if volume.CapacityBytes > 0 {
+		return *resource.NewQuantity(volume.CapacityBytes, resource.BinarySI)
+	}
+	return *resource.NewQuantity(int64(volModel.GiBytes(int(volume.Capacity))), resource.BinarySI)
+}
+
+func (k *KubeDirectClient) CreateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error {
+	k.log.WithField("namespace", namespace).Debugf("create volume %+v", volume)
+
+	accessMode := corev1.ReadWriteOnce
+	if volume.AccessMode != "" {
+		accessMode = corev1.PersistentVolumeAccessMode(volume.AccessMode)
+	}
+	claim := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        volume.Name,
+			Namespace:   namespace,
+			Labels:      map[string]string{managedByLabel: managedByValue},
+			Annotations: map[string]string{},
+		},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes: []corev1.PersistentVolumeAccessMode{accessMode},
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: claimCapacity(volume)},
+			},
+		},
+	}
+	if volume.StorageName != "" {
+		claim.Spec.StorageClassName = &volume.StorageName
+	}
+	if volume.TariffID != "" {
+		claim.Annotations[tariffAnnotation] = volume.TariffID
+	}
+
+	_, err := k.client.CoreV1().PersistentVolumeClaims(namespace).Create(ctx, claim, metav1.CreateOptions{})
+	return k.kubeError(err)
+}
+
+// UpdateVolume changes requested capacity and tariff of claim.
+// Access modes of existing claim are immutable, so access mode change is rejected.
+func (k *KubeDirectClient) UpdateVolume(ctx context.Context, namespace string, volume *volModel.KubeVolume) error {
+	k.log.WithField("namespace", namespace).Debugf("update volume %+v", volume)
+
+	claims := k.client.CoreV1().PersistentVolumeClaims(namespace)
+	claim, err := claims.Get(ctx, volume.Name, metav1.GetOptions{})
+	if err != nil {
+		return k.kubeError(err)
+	}
+	if volume.AccessMode != "" && volume.AccessMode != claimAccessMode(claim) {
+		return errors.ErrRequestValidationFailed().
+			AddDetailF("access mode of kubernetes claim %s can not be changed to %s", volume.Name, volume.AccessMode)
+	}
+
+	if claim.Annotations == nil {
+		claim.Annotations = map[string]string{}
+	}
+	if volume.TariffID != "" {
+		claim.Annotations[tariffAnnotation] = volume.TariffID
+	} else {
+		delete(claim.Annotations, tariffAnnotation)
+	}
+	if claim.Spec.Resources.Requests == nil {
+		claim.Spec.Resources.Requests = corev1.ResourceList{}
+	}
+	claim.Spec.Resources.Requests[corev1.ResourceStorage] = claimCapacity(volume)
+
+	_, err = claims.Update(ctx, claim, metav1.UpdateOptions{})
+	return k.kubeError(err)
+}
+
+// FixedAccessMode reports that access modes of existing claims are immutable
+func (k *KubeDirectClient) FixedAccessMode() bool {
+	return true
+}
+
+// DeleteVolume deletes claim. Missing claim is not an error.
+func (k *KubeDirectClient) DeleteVolume(ctx context.Context, namespace string, volumeName string) error {
+	k.log.WithField("namespace", namespace).Debugf("delete volume %s", volumeName)
+
+	err := k.client.CoreV1().PersistentVolumeClaims(namespace).Delete(ctx, volumeName, metav1.DeleteOptions{})
+	if kubeErrors.IsNotFound(err) {
+		k.log.WithField("namespace", namespace).Warnf("volume %s not found in kubernetes", volumeName)
+		return nil
+	}
+	return k.kubeError(err)
+}
+
+// GetVolume returns claim state. Reason of pending claim is taken from last warning event.
+func (k *KubeDirectClient) GetVolume(ctx context.Context, namespace string, volumeName string) (volModel.KubeVolume, error) {
+	k.log.WithField("namespace", namespace).Debugf("get volume %s", volumeName)
+
+	claim, err := k.client.CoreV1().PersistentVolumeClaims(namespace).Get(ctx, volumeName, metav1.GetOptions{})
+	if err != nil {
+		return volModel.KubeVolume{}, k.kubeError(err)
+	}
+	return k.kubeVolume(ctx, claim), nil
+}
+
+// ListVolumes returns claims created by volume-manager in namespace
+func (k *KubeDirectClient) ListVolumes(ctx context.Context, namespace string) ([]volModel.KubeVolume, error) {
+	k.log.WithField("namespace", namespace).Debugf("list volumes")
+
+	list, err := k.client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: managedByLabel + "=" + managedByValue,
+	})
+	if err != nil {
+		return nil, k.kubeError(err)
+	}
+
+	ret := make([]volModel.KubeVolume, len(list.Items))
+	for i := range list.Items {
+		ret[i] = k.kubeVolume(ctx, &list.Items[i])
+	}
+	return ret, nil
+}
+
+func (k *KubeDirectClient) kubeVolume(ctx context.Context, claim *corev1.PersistentVolumeClaim) volModel.KubeVolume {
+	ret := toKubeVolume(claim)
+	if volModel.VolumeStatusFromPhase(ret.Status) == volModel.VolumeStatusPending {
+		reason, err := k.claimWarning(ctx, claim.Namespace, claim.Name)
+		if err != nil {
+			k.log.WithError(err).WithField("namespace", claim.Namespace).Warnf("unable to get events of claim %s", claim.Name)
+		}
+		ret.Reason = reason
+	}
+	return ret
+}
+
+func claimAccessMode(claim *corev1.PersistentVolumeClaim) model.PersistentVolumeAccessMode {
+	if len(claim.Spec.AccessModes) == 0 {
+		return ""
+	}
+	return model.PersistentVolumeAccessMode(claim.Spec.AccessModes[0])
+}
+
+func toKubeVolume(claim *corev1.PersistentVolumeClaim) volModel.KubeVolume {
+	ret := volModel.KubeVolume{
+		Volume: model.Volume{
+			Name:       claim.Name,
+			Namespace:  claim.Namespace,
+			TariffID:   claim.Annotations[tariffAnnotation],
+			AccessMode: claimAccessMode(claim),
+			Status:     string(claim.Status.Phase),
+		},
+	}
+	if !claim.CreationTimestamp.IsZero() {
+		ret.CreatedAt = claim.CreationTimestamp.UTC().Format(time.RFC3339)
+	}
+	if claim.Spec.StorageClassName != nil {
+		ret.StorageName = *claim.Spec.StorageClassName
+	}
+	if capacity, ok := claim.Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+		ret.CapacityBytes = capacity.Value()
+		ret.Capacity = volModel.Quantity(ret.CapacityBytes).CeilIn(volModel.UnitGiB)
+	}
+	return ret
+}
+
+// claimWarning returns message of last warning event of claim, e.g. provisioning failure
+func (k *KubeDirectClient) claimWarning(ctx context.Context, namespace, name string) (string, error) {
+	list, err := k.client.CoreV1().Events(namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=PersistentVolumeClaim,involvedObject.name=" + name + ",type=Warning",
+	})
+	if err != nil {
+		return "", k.kubeError(err)
+	}
+
+	var last *corev1.Event
+	for i := range list.Items {
+		event := &list.Items[i]
+		if event.InvolvedObject.Name != name || event.Type != corev1.EventTypeWarning {
+			continue
+		}
+		if last == nil || event.LastTimestamp.After(last.LastTimestamp.Time) {
+			last = event
+		}
+	}
+	if last == nil {
+		return "", nil
+	}
+	return fmt.Sprintf("%s: %s", last.Reason, last.Message), nil
+}
+
+// WatchVolumes streams changes of claims created by volume-manager in all namespaces.
+// Current state of every claim is sent first. Returns when stream is closed by server or context is done.
+func (k *KubeDirectClient) WatchVolumes(ctx context.Context, handle func(volume volModel.KubeVolume, deleted bool)) error {
+	k.log.Debugf("watch volumes")
+
+	watcher, err := k.watchClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceAll).Watch(ctx, metav1.ListOptions{
+		LabelSelector: managedByLabel + "=" + managedByValue,
+	})
+	if err != nil {
+		return k.kubeError(err)
+	}
+	defer watcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.ResultChan():
+			if !ok {
+				return nil
+			}
+			claim, isClaim := event.Object.(*corev1.PersistentVolumeClaim)
+			switch {
+			case event.Type == watch.Error:
+				// object is a status, e.g. when watched resource version expired
+				return fmt.Errorf("kubernetes watch failed: %v", kubeErrors.FromObject(event.Object))
+			case !isClaim:
+				// pass
+			case event.Type == watch.Added, event.Type == watch.Modified:
+				handle(k.kubeVolume(ctx, claim), false)
+			case event.Type == watch.Deleted:
+				handle(toKubeVolume(claim), true)
+			}
+		}
+	}
+}
+
+func (k *KubeDirectClient) GetStorageClasses(ctx context.Context) ([]volModel.StorageClass, error) {
+	k.log.Debugf("get storage classes")
+
+	list, err := k.client.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, k.kubeError(err)
+	}
+
+	ret := make([]volModel.StorageClass, 0, len(list.Items))
+	for _, item := range list.Items {
+		class := volModel.StorageClass{
+			Name:        item.Name,
+			Provisioner: item.Provisioner,
+			Parameters:  item.Parameters,
+		}
+		if capacity, ok := item.Annotations[StorageClassCapacityAnnotation]; ok {
+			if class.Capacity, err = volModel.ParseQuantity(capacity); err != nil {
+				k.log.WithError(err).WithField("storage_class", item.Name).Warnf("invalid capacity annotation")
+			}
+		}
+		ret = append(ret, class)
+	}
+	return ret, nil
+}
+
+// SuspendVolume marks claim with SuspendedAnnotation and detaches it from workloads:
+// deployments and stateful sets mounting claim are scaled to zero, their replicas are kept in SuspendedReplicasAnnotation.
+// Pods mounting claim without controller are deleted.
+func (k *KubeDirectClient) SuspendVolume(ctx context.Context, namespace, volumeName string) error {
+	k.log.WithField("namespace", namespace).Debugf("suspend volume %s", volumeName)
+
+	if err := k.setClaimSuspended(ctx, namespace, volumeName, true); err != nil {
+		return err
+	}
+
+	workloads, err := k.workloads(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, workload := range workloads {
+		if _, scaledDown := workload.meta.Annotations[SuspendedReplicasAnnotation]; scaledDown || !podSpecMounts(workload.spec, volumeName) {
+			continue
+		}
+		replicas := int32(1)
+		if *workload.replicas != nil {
+			replicas = **workload.replicas
+		}
+		if workload.meta.Annotations == nil {
+			workload.meta.Annotations = map[string]string{}
+		}
+		workload.meta.Annotations[SuspendedReplicasAnnotation] = strconv.Itoa(int(replicas))
+		zero := int32(0)
+		*workload.replicas = &zero
+		if err := workload.update(ctx); err != nil {
+			return k.kubeError(err)
+		}
+	}
+
+	pods, err := k.client.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return k.kubeError(err)
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if metav1.GetControllerOf(pod) != nil || !podSpecMounts(&pod.Spec, volumeName) {
+			continue
+		}
+		err := k.client.CoreV1().Pods(namespace).Delete(ctx, pod.Name, metav1.DeleteOptions{})
+		if err != nil && !kubeErrors.IsNotFound(err) {
+			return k.kubeError(err)
+		}
+	}
+	return nil
+}
+
+// ResumeVolume removes SuspendedAnnotation of claim and restores replicas of workloads mounting it,
+// unless workload mounts other suspended claim.
+func (k *KubeDirectClient) ResumeVolume(ctx context.Context, namespace, volumeName string) error {
+	k.log.WithField("namespace", namespace).Debugf("resume volume %s", volumeName)
+
+	if err := k.setClaimSuspended(ctx, namespace, volumeName, false); err != nil {
+		return err
+	}
+
+	claims, err := k.client.CoreV1().PersistentVolumeClaims(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return k.kubeError(err)
+	}
+	suspended := make(map[string]bool)
+	for _, claim := range claims.Items {
+		if claim.Annotations[SuspendedAnnotation] == "true" {
+			suspended[claim.Name] = true
+		}
+	}
+
+	workloads, err := k.workloads(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	for _, workload := range workloads {
+		value, scaledDown := workload.meta.Annotations[SuspendedReplicasAnnotation]
+		if !scaledDown || !podSpecMounts(workload.spec, volumeName) || podSpecMountsAny(workload.spec, suspended) {
+			continue
+		}
+		replicas, err := strconv.Atoi(value)
+		if err != nil {
+			k.log.WithError(err).WithField("namespace", namespace).Warnf("invalid suspended replicas of %s %s", workload.kind, workload.meta.Name)
+			replicas = 1
+		}
+		restored := int32(replicas)
+		*workload.replicas = &restored
+		delete(workload.meta.Annotations, SuspendedReplicasAnnotation)
+		if err := workload.update(ctx); err != nil {
+			return k.kubeError(err)
+		}
+	}
+	return nil
+}
+
+func (k *KubeDirectClient) setClaimSuspended(ctx context.Context, namespace, name string, suspended bool) error {
+	claims := k.client.CoreV1().PersistentVolumeClaims(namespace)
+	claim, err := claims.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return k.kubeError(err)
+	}
+	if claim.Annotations == nil {
+		claim.Annotations = map[string]string{}
+	}
+	if suspended {
+		claim.Annotations[SuspendedAnnotation] = "true"
+	} else {
+		delete(claim.Annotations, SuspendedAnnotation)
+	}
+	_, err = claims.Update(ctx, claim, metav1.UpdateOptions{})
+	return k.kubeError(err)
+}
+
+// suspendableWorkload is a deployment or stateful set scaled down while volume it mounts is suspended
+type suspendableWorkload struct {
+	kind     string
+	meta     *metav1.ObjectMeta
+	replicas **int32
+	spec     *corev1.PodSpec
+	update   func(ctx context.Context) error
+}
+
+func (k *KubeDirectClient) workloads(ctx context.Context, namespace string) ([]suspendableWorkload, error) {
+	deployments, err := k.client.AppsV1().Deployments(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, k.kubeError(err)
+	}
+	statefulSets, err := k.client.AppsV1().StatefulSets(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, k.kubeError(err)
+	}
+
+	ret := make([]suspendableWorkload, 0, len(deployments.Items)+len(statefulSets.Items))
+	for i := range deployments.Items {
+		deployment := &deployments.Items[i]
+		ret = append(ret, suspendableWorkload{
+			kind:     "deployment",
+			meta:     &deployment.ObjectMeta,
+			replicas: &deployment.Spec.Replicas,
+			spec:     &deployment.Spec.Template.Spec,
+			update: func(ctx context.Context) error {
+				_, err := k.client.AppsV1().Deployments(namespace).Update(ctx, deployment, metav1.UpdateOptions{})
+				return err
+			},
+		})
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		ret = append(ret, suspendableWorkload{
+			kind:     "stateful set",
+			meta:     &statefulSet.ObjectMeta,
+			replicas: &statefulSet.Spec.Replicas,
+			spec:     &statefulSet.Spec.Template.Spec,
+			update: func(ctx context.Context) error {
+				_, err := k.client.AppsV1().StatefulSets(namespace).Update(ctx, statefulSet, metav1.UpdateOptions{})
+				return err
+			},
+		})
+	}
+	return ret, nil
+}
+
+func podSpecMounts(spec *corev1.PodSpec, claim string) bool {
+	return podSpecMountsAny(spec, map[string]bool{claim: true})
+}
+
+func podSpecMountsAny(spec *corev1.PodSpec, claims map[string]bool) bool {
+	for _, volume := range spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && claims[volume.PersistentVolumeClaim.ClaimName] {
+			return true
+		}
+	}
+	return false
+}
+
+// kubeError converts kubernetes API error to cherry error
+func (k *KubeDirectClient) kubeError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case kubeErrors.IsNotFound(err):
+		return errors.ErrResourceNotExists().AddDetailsErr(err)
+	case kubeErrors.IsAlreadyExists(err), kubeErrors.IsConflict(err):
+		return errors.ErrResourceAlreadyExists().AddDetailsErr(err)
+	}
+	if _, isStatus := err.(kubeErrors.APIStatus); !isStatus {
+		if cherryErr, ok := serviceError(err).(*cherry.Err); ok {
+			return cherryErr
+		}
+	}
+	k.log.WithError(err).Errorf("kubernetes request failed")
+	return errors.ErrInternal().AddDetailF("kubernetes: %v", err)
+}
+
+// BreakerState returns state of kubernetes API circuit breaker
+func (k *KubeDirectClient) BreakerState() BreakerState {
+	return k.transport.breaker.State()
+}
+
+func (k *KubeDirectClient) String() string {
+	return fmt.Sprintf("kubernetes direct client: host=%s", k.host)
+}
@@ -0,0 +1,199 @@
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	volModel "git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/containerum/kube-client/pkg/model"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestKubeDirectClient(t *testing.T) {
+	kube := fake.NewSimpleClientset(&storagev1.StorageClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "ceph",
+			Annotations: map[string]string{StorageClassCapacityAnnotation: "100Gi"},
+		},
+		Provisioner: "kubernetes.io/rbd",
+	})
+	client := newKubeDirectClient(kube, kube)
+	ctx := context.Background()
+	claim := func() *corev1.PersistentVolumeClaim {
+		ret, err := kube.CoreV1().PersistentVolumeClaims("ns").Get(ctx, "vol", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ret
+	}
+
+	volume := &volModel.KubeVolume{
+		Volume: model.Volume{
+			Name:        "vol",
+			Capacity:    5,
+			StorageName: "ceph",
+			AccessMode:  model.ReadWriteOnce,
+			TariffID:    "tariff",
+		},
+		CapacityBytes: int64(5 * volModel.GiB),
+	}
+	if err := client.CreateVolume(ctx, "ns", volume); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CreateVolume(ctx, "ns", volume); !cherry.Equals(err, errors.ErrResourceAlreadyExists()) {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+
+	created := claim()
+	if v := created.Spec.StorageClassName; v == nil || *v != "ceph" {
+		t.Errorf("unexpected storage class %v", v)
+	}
+	if v := created.Spec.Resources.Requests[corev1.ResourceStorage]; v.String() != "5Gi" {
+		t.Errorf("unexpected requested storage %v", v.String())
+	}
+	if v := created.Spec.AccessModes; len(v) != 1 || v[0] != corev1.ReadWriteOnce {
+		t.Errorf("unexpected access modes %v", v)
+	}
+
+	kubeVol, err := client.GetVolume(ctx, "ns", "vol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kubeVol.Capacity != 5 || kubeVol.CapacityBytes != int64(5*volModel.GiB) || kubeVol.StorageName != "ceph" || kubeVol.TariffID != "tariff" || kubeVol.AccessMode != model.ReadWriteOnce {
+		t.Errorf("unexpected volume %+v", kubeVol)
+	}
+	if _, err := client.GetVolume(ctx, "ns", "missing"); !cherry.Equals(err, errors.ErrResourceNotExists()) {
+		t.Errorf("expected not exists error, got %v", err)
+	}
+
+	list, err := client.ListVolumes(ctx, "ns")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].Name != "vol" {
+		t.Errorf("unexpected volumes list %+v", list)
+	}
+
+	volume.Capacity = 11
+	volume.CapacityBytes = int64(10*volModel.GiB + 512*volModel.MiB)
+	if err := client.UpdateVolume(ctx, "ns", volume); err != nil {
+		t.Fatal(err)
+	}
+	if v := claim().Spec.Resources.Requests[corev1.ResourceStorage]; v.String() != "10752Mi" {
+		t.Errorf("unexpected requested storage after resize %v", v.String())
+	}
+	if kubeVol, err := client.GetVolume(ctx, "ns", "vol"); err != nil || kubeVol.CapacityBytes != volume.CapacityBytes || kubeVol.Capacity != 11 {
+		t.Errorf("expected exact capacity after resize, got %+v (%v)", kubeVol, err)
+	}
+
+	volume.AccessMode = model.ReadOnlyMany
+	if err := client.UpdateVolume(ctx, "ns", volume); !cherry.Equals(err, errors.ErrRequestValidationFailed()) {
+		t.Errorf("expected access mode change to be rejected, got %v", err)
+	}
+	if v := claim().Spec.AccessModes; len(v) != 1 || v[0] != corev1.ReadWriteOnce {
+		t.Errorf("expected access modes to be kept, got %v", v)
+	}
+
+	if err := client.UpdateVolume(ctx, "ns", &volModel.KubeVolume{Volume: model.Volume{Name: "missing"}}); !cherry.Equals(err, errors.ErrResourceNotExists()) {
+		t.Errorf("expected not exists error on updating missing claim, got %v", err)
+	}
+
+	if err := client.DeleteVolume(ctx, "ns", "vol"); err != nil {
+		t.Fatal(err)
+	}
+	if list, _ := client.ListVolumes(ctx, "ns"); len(list) != 0 {
+		t.Errorf("expected claim to be deleted, got %+v", list)
+	}
+	if err := client.DeleteVolume(ctx, "ns", "vol"); err != nil {
+		t.Errorf("expected deleting missing claim to succeed, got %v", err)
+	}
+
+	classes, err := client.GetStorageClasses(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(classes) != 1 || classes[0].Name != "ceph" || classes[0].Provisioner != "kubernetes.io/rbd" || classes[0].Capacity.String() != "100Gi" {
+		t.Errorf("unexpected storage classes %+v", classes)
+	}
+}
+
+func TestKubeDirectClientSuspendVolume(t *testing.T) {
+	replicas := int32(3)
+	mounting := func(claims ...string) corev1.PodSpec {
+		var spec corev1.PodSpec
+		for _, claim := range claims {
+			spec.Volumes = append(spec.Volumes, corev1.Volume{
+				Name:         claim,
+				VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claim}},
+			})
+		}
+		return spec
+	}
+	kube := fake.NewSimpleClientset(
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "ns"}},
+		&corev1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: "logs", Namespace: "ns"}},
+		&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "ns"},
+			Spec: appsv1.DeploymentSpec{
+				Replicas: &replicas,
+				Template: corev1.PodTemplateSpec{Spec: mounting("data", "logs")},
+			},
+		},
+		&appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "ns"},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: &replicas,
+				Template: corev1.PodTemplateSpec{Spec: mounting("other")},
+			},
+		},
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "debug", Namespace: "ns"}, Spec: mounting("data")},
+	)
+	client := newKubeDirectClient(kube, kube)
+	ctx := context.Background()
+	deploymentReplicas := func() int32 {
+		deployment, err := kube.AppsV1().Deployments("ns").Get(ctx, "app", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return *deployment.Spec.Replicas
+	}
+
+	if err := client.SuspendVolume(ctx, "ns", "data"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SuspendVolume(ctx, "ns", "logs"); err != nil {
+		t.Fatal(err)
+	}
+	if n := deploymentReplicas(); n != 0 {
+		t.Errorf("expected deployment mounting suspended volume to be scaled down, got %d replicas", n)
+	}
+	if statefulSet, _ := kube.AppsV1().StatefulSets("ns").Get(ctx, "other", metav1.GetOptions{}); *statefulSet.Spec.Replicas != 3 {
+		t.Errorf("expected stateful set not mounting suspended volume to be kept, got %d replicas", *statefulSet.Spec.Replicas)
+	}
+	if _, err := kube.CoreV1().Pods("ns").Get(ctx, "debug", metav1.GetOptions{}); err == nil {
+		t.Errorf("expected pod mounting suspended volume to be deleted")
+	}
+
+	if err := client.ResumeVolume(ctx, "ns", "data"); err != nil {
+		t.Fatal(err)
+	}
+	if n := deploymentReplicas(); n != 0 {
+		t.Errorf("expected deployment mounting other suspended volume to stay scaled down, got %d replicas", n)
+	}
+	if err := client.ResumeVolume(ctx, "ns", "logs"); err != nil {
+		t.Fatal(err)
+	}
+	if n := deploymentReplicas(); n != 3 {
+		t.Errorf("expected deployment replicas to be restored, got %d", n)
+	}
+
+	if err := client.SuspendVolume(ctx, "ns", "missing"); !cherry.Equals(err, errors.ErrResourceNotExists()) {
+		t.Errorf("expected not exists error, got %v", err)
+	}
+}
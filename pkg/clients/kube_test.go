@@ -0,0 +1,110 @@
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	volModel "git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/containerum/kube-client/pkg/model"
+	"github.com/containerum/utils/httputil"
+	"github.com/gin-gonic/gin"
+)
+
+func TestKubeAPIDummyClient(t *testing.T) {
+	client := NewKubeAPIDummyClient()
+	ctx := context.Background()
+
+	if err := client.CreateVolume(ctx, "ns", &volModel.KubeVolume{Volume: model.Volume{Name: "vol", Capacity: 1}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.CreateVolume(ctx, "ns", &volModel.KubeVolume{Volume: model.Volume{Name: "vol"}}); !cherry.Equals(err, errors.ErrResourceAlreadyExists()) {
+		t.Errorf("expected already exists error, got %v", err)
+	}
+	update := &volModel.KubeVolume{Volume: model.Volume{Name: "vol", Capacity: 2}, CapacityBytes: int64(1536 * volModel.MiB)}
+	if err := client.UpdateVolume(ctx, "ns", update); err != nil {
+		t.Fatal(err)
+	}
+
+	vol, err := client.GetVolume(ctx, "ns", "vol")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vol.Capacity != 2 || vol.CapacityBytes != int64(1536*volModel.MiB) || vol.Namespace != "ns" || vol.Status != "Bound" || vol.CreatedAt == "" {
+		t.Errorf("unexpected volume %+v", vol)
+	}
+
+	if list, _ := client.ListVolumes(ctx, "ns"); len(list) != 1 {
+		t.Errorf("expected 1 volume in namespace, got %d", len(list))
+	}
+	if list, _ := client.ListVolumes(ctx, "other"); len(list) != 0 {
+		t.Errorf("expected no volumes in other namespace, got %d", len(list))
+	}
+
+	if err := client.SuspendVolume(ctx, "ns", "vol"); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.RenameVolume(ctx, "ns", "vol", "data"); err != nil {
+		t.Fatal(err)
+	}
+	if !client.suspended["ns/data"] || client.suspended["ns/vol"] {
+		t.Errorf("expected suspension to follow renamed volume, got %v", client.suspended)
+	}
+	if renamed, err := client.GetVolume(ctx, "ns", "data"); err != nil || renamed.Name != "data" || renamed.Capacity != 2 {
+		t.Errorf("expected renamed volume, got %+v (%v)", renamed, err)
+	}
+	if err := client.RenameVolume(ctx, "ns", "vol", "other"); !cherry.Equals(err, errors.ErrResourceNotExists()) {
+		t.Errorf("expected not exists error, got %v", err)
+	}
+	if err := client.RenameVolume(ctx, "ns", "data", "vol"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := client.ResumeVolume(ctx, "ns", "vol"); err != nil || len(client.suspended) != 0 {
+		t.Errorf("expected volume to be resumed, got %v (%v)", client.suspended, err)
+	}
+	if err := client.DeleteVolume(ctx, "ns", "vol"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.GetVolume(ctx, "ns", "vol"); !cherry.Equals(err, errors.ErrResourceNotExists()) {
+		t.Errorf("expected not exists error, got %v", err)
+	}
+}
+
+func TestValidateGiBCapacity(t *testing.T) {
+	client := new(KubeAPIHTTPClient)
+	if err := client.ValidateCapacity(2 * volModel.GiB); err != nil {
+		t.Errorf("expected whole GiB capacity to be accepted, got %v", err)
+	}
+	if err := client.ValidateCapacity(512 * volModel.MiB); !cherry.Equals(err, errors.ErrRequestValidationFailed()) {
+		t.Errorf("expected validation error for sub-GiB capacity, got %v", err)
+	}
+}
+
+func TestKubeAPIHTTPClientVolumeNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		json.NewEncoder(w).Encode(cherry.Err{
+			Message:    "Resource not found",
+			StatusHTTP: http.StatusNotFound,
+			ID:         cherry.ErrID{SID: "kube-api", Kind: 6},
+		})
+	}))
+	defer srv.Close()
+
+	// client passes request headers saved in context
+	gctx := &gin.Context{Request: &http.Request{Header: make(http.Header)}}
+	httputil.SaveHeaders(gctx)
+
+	u, _ := url.Parse(srv.URL)
+	client := NewKubeAPIHTTPClient(u, ResilienceConfig{})
+	if _, err := client.GetVolume(gctx.Request.Context(), "ns", "vol"); !cherry.Equals(err, errors.ErrResourceNotExists()) {
+		t.Errorf("expected not exists error, got %v", err)
+	}
+}
@@ -0,0 +1,286 @@
+package clients
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// ResilienceConfig configures timeouts, retries and circuit breaker of service HTTP clients
+type ResilienceConfig struct {
+	// Timeout of single request attempt, zero means no timeout
+	Timeout time.Duration
+
+	// Number of retries of idempotent requests failed with network error or 5xx status
+	Retries int
+
+	// Backoff between retries grows exponentially from RetryWaitTime up to RetryMaxWaitTime with random jitter
+	RetryWaitTime    time.Duration
+	RetryMaxWaitTime time.Duration
+
+	// Number of consecutive failures which opens circuit breaker, zero disables breaker
+	BreakerThreshold int
+
+	// Time after which open breaker lets trial request through
+	BreakerTimeout time.Duration
+
+	// Log requests and responses
+	Debug bool
+}
+
+// DefaultResilienceConfig is used when clients created without explicit configuration
+var DefaultResilienceConfig = ResilienceConfig{
+	Timeout:          10 * time.Second,
+	Retries:          3,
+	RetryWaitTime:    100 * time.Millisecond,
+	RetryMaxWaitTime: 2 * time.Second,
+	BreakerThreshold: 5,
+	BreakerTimeout:   30 * time.Second,
+}
+
+// BreakerState describes circuit breaker state
+type BreakerState string
+
+const (
+	BreakerClosed   BreakerState = "closed"
+	BreakerOpen     BreakerState = "open"
+	BreakerHalfOpen BreakerState = "half-open"
+)
+
+type circuitBreaker struct {
+	threshold int
+	timeout   time.Duration
+	now       func() time.Time
+
+	mu           sync.Mutex
+	state        BreakerState
+	failures     int
+	openedAt     time.Time
+	trialRunning bool
+}
+
+func newCircuitBreaker(threshold int, timeout time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		timeout:   timeout,
+		now:       time.Now,
+		state:     BreakerClosed,
+	}
+}
+
+// allow reports if request may be done. Half-open breaker allows only one trial request.
+func (b *circuitBreaker) allow() bool {
+	if b.threshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case BreakerOpen:
+		if b.now().Sub(b.openedAt) < b.timeout {
+			return false
+		}
+		b.state = BreakerHalfOpen
+		fallthrough
+	case BreakerHalfOpen:
+		if b.trialRunning {
+			return false
+		}
+		b.trialRunning = true
+	}
+	return true
+}
+
+func (b *circuitBreaker) record(success bool) {
+	if b.threshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trialRunning = false
+	if success {
+		b.failures = 0
+		b.state = BreakerClosed
+		return
+	}
+	b.failures++
+	if b.state == BreakerHalfOpen || b.failures >= b.threshold {
+		b.state = BreakerOpen
+		b.openedAt = b.now()
+	}
+}
+
+// forget releases trial request slot without changing breaker state, used when request was cancelled by caller
+func (b *circuitBreaker) forget() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.trialRunning = false
+}
+
+func (b *circuitBreaker) State() BreakerState {
+	if b.threshold <= 0 {
+		return BreakerClosed
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.state == BreakerOpen && b.now().Sub(b.openedAt) >= b.timeout {
+		return BreakerHalfOpen
+	}
+	return b.state
+}
+
+type circuitOpenError struct {
+	service string
+}
+
+func (e *circuitOpenError) Error() string {
+	return fmt.Sprintf("%s circuit breaker is open", e.service)
+}
+
+// serviceError replaces open circuit breaker error with ErrServiceUnavailable
+func serviceError(err error) error {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	if openErr, ok := err.(*circuitOpenError); ok {
+		return errors.ErrServiceUnavailable().AddDetailsErr(openErr)
+	}
+	return err
+}
+
+// resilientTransport applies timeouts, retries and circuit breaker to requests
+type resilientTransport struct {
+	service string
+	next    http.RoundTripper
+	cfg     ResilienceConfig
+	breaker *circuitBreaker
+	log     *logrus.Entry
+}
+
+func newResilientTransport(service string, cfg ResilienceConfig, log *logrus.Entry) *resilientTransport {
+	return &resilientTransport{
+		service: service,
+		next:    http.DefaultTransport,
+		cfg:     cfg,
+		breaker: newCircuitBreaker(cfg.BreakerThreshold, cfg.BreakerTimeout),
+		log:     log,
+	}
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	retries := 0
+	if isIdempotent(req.Method) {
+		retries = t.cfg.Retries
+	}
+
+	for attempt := 0; ; attempt++ {
+		if !t.breaker.allow() {
+			return nil, &circuitOpenError{service: t.service}
+		}
+
+		resp, err := t.attempt(req, attempt)
+		if req.Context().Err() != nil {
+			t.breaker.forget()
+			return resp, err
+		}
+
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		t.breaker.record(!failed)
+		if !failed || attempt >= retries {
+			return resp, err
+		}
+
+		if resp != nil {
+			io.Copy(ioutil.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		wait := t.backoff(attempt)
+		t.log.WithFields(logrus.Fields{
+			"method":  req.Method,
+			"url":     req.URL.String(),
+			"attempt": attempt + 1,
+			"wait":    wait,
+		}).WithError(err).Warnf("request failed, retrying")
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+func (t *resilientTransport) attempt(req *http.Request, n int) (*http.Response, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.cfg.Timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), t.cfg.Timeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+
+	attemptReq := req.WithContext(ctx)
+	if n > 0 && req.Body != nil {
+		if req.GetBody == nil {
+			cancel()
+			return nil, fmt.Errorf("request body can not be sent again")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attemptReq.Body = body
+	}
+
+	resp, err := t.next.RoundTrip(attemptReq)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff returns capped exponential wait time with jitter
+func (t *resilientTransport) backoff(attempt int) time.Duration {
+	wait := math.Min(float64(t.cfg.RetryMaxWaitTime), float64(t.cfg.RetryWaitTime)*math.Exp2(float64(attempt)))
+	if wait < 2 {
+		return time.Duration(wait)
+	}
+	return time.Duration(wait/2 + rand.Float64()*wait/2)
+}
+
+// cancelOnClose cancels attempt context when response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
@@ -0,0 +1,87 @@
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(2, time.Minute)
+	b.now = func() time.Time { return now }
+
+	b.record(false)
+	if b.State() != BreakerClosed {
+		t.Fatalf("expected closed breaker after single failure, got %s", b.State())
+	}
+	b.record(false)
+	if b.State() != BreakerOpen || b.allow() {
+		t.Fatalf("expected open breaker rejecting requests, got %s", b.State())
+	}
+
+	now = now.Add(time.Minute)
+	if b.State() != BreakerHalfOpen {
+		t.Fatalf("expected half-open breaker after timeout, got %s", b.State())
+	}
+	if !b.allow() {
+		t.Fatalf("expected trial request to be allowed")
+	}
+	if b.allow() {
+		t.Fatalf("expected only one trial request")
+	}
+	b.record(false)
+	if b.State() != BreakerOpen {
+		t.Fatalf("expected breaker to open after failed trial, got %s", b.State())
+	}
+
+	now = now.Add(time.Minute)
+	if !b.allow() {
+		t.Fatalf("expected trial request to be allowed")
+	}
+	b.record(true)
+	if b.State() != BreakerClosed {
+		t.Fatalf("expected breaker to close after successful trial, got %s", b.State())
+	}
+}
+
+func TestResilientTransportRetries(t *testing.T) {
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	transport := newResilientTransport("test", ResilienceConfig{
+		Timeout:          time.Second,
+		Retries:          2,
+		RetryWaitTime:    time.Millisecond,
+		RetryMaxWaitTime: time.Millisecond,
+	}, logrus.NewEntry(logrus.StandardLogger()))
+	client := &http.Client{Transport: transport}
+
+	resp, err := client.Get(srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 3 {
+		t.Errorf("expected GET to be sent 3 times, got %d", calls)
+	}
+
+	calls = 0
+	resp, err = client.Post(srv.URL, "application/json", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if calls != 1 {
+		t.Errorf("expected POST to be sent once, got %d", calls)
+	}
+}
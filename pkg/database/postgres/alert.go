@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/sirupsen/logrus"
+)
+
+// RaiseCapacityThreshold stores reached threshold and reports if it is higher than stored one.
+// Lower threshold is stored without raise, zero threshold resets state.
+func (pgdb *PgDB) RaiseCapacityThreshold(ctx context.Context, threshold *model.CapacityThreshold) (bool, error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"source":    threshold.Source,
+		"kind":      threshold.Kind,
+		"name":      threshold.Name,
+		"threshold": threshold.Threshold,
+	}).Debugf("raise capacity threshold")
+
+	if threshold.Threshold <= 0 {
+		_, err := pgdb.db.Model(threshold).
+			WherePK().
+			Delete()
+		return false, pgdb.handleError(err)
+	}
+
+	// usage fell below stored threshold
+	_, err := pgdb.db.Model(threshold).
+		Set("threshold = ?threshold").
+		WherePK().
+		Where("threshold > ?threshold").
+		Update()
+	if err != nil {
+		return false, pgdb.handleError(err)
+	}
+
+	// conditional upsert affects row only in one of concurrent callers
+	result, err := pgdb.db.Model(threshold).
+		OnConflict("(source, kind, name) DO UPDATE").
+		Set("threshold = EXCLUDED.threshold").
+		Where("?TableAlias.threshold < EXCLUDED.threshold").
+		Insert()
+	if err != nil {
+		return false, pgdb.handleError(err)
+	}
+	return result.RowsAffected() > 0, nil
+}
@@ -0,0 +1,85 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/pg"
+	"github.com/sirupsen/logrus"
+)
+
+func (pgdb *PgDB) SaveUsageSnapshots(ctx context.Context, storages []model.StorageUsageSnapshot, namespaces []model.NamespaceUsageSnapshot) error {
+	pgdb.log.WithFields(logrus.Fields{
+		"storages":   len(storages),
+		"namespaces": len(namespaces),
+	}).Debugf("save usage snapshots")
+
+	if len(storages) > 0 {
+		_, err := pgdb.db.Model(&storages).
+			OnConflict("(storage_name, date) DO UPDATE").
+			Set("size = EXCLUDED.size").
+			Set("used = EXCLUDED.used").
+			Insert()
+		if err != nil {
+			return pgdb.handleError(err)
+		}
+	}
+
+	if len(namespaces) > 0 {
+		_, err := pgdb.db.Model(&namespaces).
+			OnConflict("(storage_name, ns_id, date) DO UPDATE").
+			Set("volumes = EXCLUDED.volumes").
+			Set("capacity = EXCLUDED.capacity").
+			Insert()
+		if err != nil {
+			return pgdb.handleError(err)
+		}
+	}
+
+	return nil
+}
+
+func (pgdb *PgDB) StorageUsageHistory(ctx context.Context, name string, since time.Time) (ret []model.StorageUsageSnapshot, err error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"name":  name,
+		"since": since,
+	}).Debugf("get storage usage history")
+
+	ret = make([]model.StorageUsageSnapshot, 0)
+	err = pgdb.db.Model(&ret).
+		Where("storage_name = ?", name).
+		Where("date >= ?", since).
+		Order("date").
+		Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) NamespaceUsageHistory(ctx context.Context, name string, since time.Time) (ret []model.NamespaceUsageSnapshot, err error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"name":  name,
+		"since": since,
+	}).Debugf("get namespaces usage history")
+
+	ret = make([]model.NamespaceUsageSnapshot, 0)
+	err = pgdb.db.Model(&ret).
+		Where("storage_name = ?", name).
+		Where("date >= ?", since).
+		Order("date").
+		Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
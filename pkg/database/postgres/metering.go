@@ -0,0 +1,95 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/sirupsen/logrus"
+)
+
+// recordMetering closes open metering periods of changed volumes and opens new ones for alive volumes.
+// Period is kept open if metered attributes of volume did not change.
+func (pgdb *PgDB) recordMetering(volumes ...model.Volume) error {
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	volIDs := make([]string, len(volumes))
+	for i := range volumes {
+		volIDs[i] = volumes[i].ID
+	}
+
+	var open []model.MeteringPeriod
+	err := pgdb.db.Model(&open).
+		Where("volume_id IN (?)", pg.In(volIDs)).
+		Where("end_time IS NULL").
+		Select()
+	if err != nil && err != pg.ErrNoRows {
+		return err
+	}
+	openByVolume := make(map[string]model.MeteringPeriod, len(open))
+	for _, period := range open {
+		openByVolume[period.VolumeID] = period
+	}
+
+	var toClose []int64
+	var toOpen []model.MeteringPeriod
+	for _, vol := range volumes {
+		current, hasOpen := openByVolume[vol.ID]
+		next := model.NewMeteringPeriod(vol)
+		if hasOpen && !vol.Deleted && current.SameAllocation(next) {
+			continue
+		}
+		if hasOpen {
+			toClose = append(toClose, current.ID)
+		}
+		if !vol.Deleted {
+			toOpen = append(toOpen, next)
+		}
+	}
+
+	if len(toClose) > 0 {
+		_, err = pgdb.db.Model((*model.MeteringPeriod)(nil)).
+			Set("end_time = now()").
+			Where("id IN (?)", pg.In(toClose)).
+			Update()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(toOpen) > 0 {
+		if _, err = pgdb.db.Model(&toOpen).Insert(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (pgdb *PgDB) MeteringPeriods(ctx context.Context, from, to time.Time) (ret []model.MeteringPeriod, err error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"from": from,
+		"to":   to,
+	}).Debugf("get metering periods")
+
+	ret = make([]model.MeteringPeriod, 0)
+	err = pgdb.db.Model(&ret).
+		Where("start_time < ?", to).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.WhereOr("end_time IS NULL").WhereOr("end_time > ?", from), nil
+		}).
+		Order("start_time", "id").
+		Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
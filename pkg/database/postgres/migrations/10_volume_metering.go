@@ -0,0 +1,31 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+	"github.com/go-pg/pg/orm"
+)
+
+// metering starts with open periods for volumes existing at migration time
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		if _, err := orm.CreateTable(db, &model.MeteringPeriod{}, &orm.CreateTableOptions{IfNotExists: true}); err != nil {
+			return err
+		}
+
+		if _, err := db.Model(&model.MeteringPeriod{}).Exec( /* language=sql */
+			`CREATE INDEX IF NOT EXISTS "volume_metering_volume_id_idx" ON "?TableName" ("volume_id") WHERE "end_time" IS NULL;`); err != nil {
+			return err
+		}
+
+		_, err := db.Model(&model.MeteringPeriod{}).Exec( /* language=sql */
+			`INSERT INTO "?TableName" ("volume_id", "label", "ns_id", "storage_name", "tariff_id", "capacity", "start_time")
+						SELECT "id", "label", coalesce("ns_id", ''), "storage_name", coalesce("tariff_id"::TEXT, ''), "capacity", "create_time"
+						FROM "volumes"
+						WHERE NOT "deleted";`)
+		return err
+	}, func(db migrations.DB) error {
+		_, err := orm.DropTable(db, &model.MeteringPeriod{}, &orm.DropTableOptions{IfExists: true})
+		return err
+	})
+}
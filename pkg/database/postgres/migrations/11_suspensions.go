@@ -0,0 +1,27 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+	"github.com/go-pg/pg/orm"
+)
+
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		if _, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName" ADD COLUMN IF NOT EXISTS "suspended" BOOLEAN NOT NULL DEFAULT FALSE;`); err != nil {
+			return err
+		}
+
+		_, err := orm.CreateTable(db, &model.Suspension{}, &orm.CreateTableOptions{IfNotExists: true})
+		return err
+	}, func(db migrations.DB) error {
+		if _, err := orm.DropTable(db, &model.Suspension{}, &orm.DropTableOptions{IfExists: true}); err != nil {
+			return err
+		}
+
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName" DROP COLUMN IF EXISTS "suspended";`)
+		return err
+	})
+}
@@ -0,0 +1,25 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+)
+
+// status of existing volumes is unknown until first check by status watcher
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+				ADD COLUMN IF NOT EXISTS "status" TEXT NOT NULL DEFAULT 'pending',
+				ADD COLUMN IF NOT EXISTS "bound_time" TIMESTAMPTZ,
+				ADD COLUMN IF NOT EXISTS "status_reason" TEXT NOT NULL DEFAULT '';`)
+		return err
+	}, func(db migrations.DB) error {
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+				DROP COLUMN IF EXISTS "status",
+				DROP COLUMN IF EXISTS "bound_time",
+				DROP COLUMN IF EXISTS "status_reason";`)
+		return err
+	})
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+)
+
+// existing volumes get empty cluster and are routed by storage
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName" ADD COLUMN IF NOT EXISTS "cluster" TEXT NOT NULL DEFAULT '';`)
+		return err
+	}, func(db migrations.DB) error {
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName" DROP COLUMN IF EXISTS "cluster";`)
+		return err
+	})
+}
@@ -0,0 +1,19 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+)
+
+// index for keyset pagination of volumes lists
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`CREATE INDEX IF NOT EXISTS volumes_create_time_id ON "?TableName" ("create_time", "id")`)
+		return err
+	}, func(db migrations.DB) error {
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`DROP INDEX IF EXISTS volumes_create_time_id`)
+		return err
+	})
+}
@@ -0,0 +1,38 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+	"github.com/go-pg/pg/orm"
+)
+
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		if _, err := orm.CreateTable(db, &model.WebhookSubscription{}, &orm.CreateTableOptions{IfNotExists: true}); err != nil {
+			return err
+		}
+
+		if _, err := orm.CreateTable(db, &model.WebhookDelivery{}, &orm.CreateTableOptions{IfNotExists: true}); err != nil {
+			return err
+		}
+
+		if _, err := db.Model(&model.WebhookDelivery{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+				ADD CONSTRAINT webhook_subscription_fk FOREIGN KEY (subscription_id)
+				REFERENCES webhook_subscriptions ("id")
+				ON DELETE CASCADE`); err != nil {
+			return err
+		}
+
+		_, err := db.Model(&model.WebhookDelivery{}).Exec( /* language=sql */
+			`CREATE INDEX IF NOT EXISTS webhook_deliveries_pending ON "?TableName" ("next_attempt_time") WHERE status = 'pending'`)
+		return err
+	}, func(db migrations.DB) error {
+		if _, err := orm.DropTable(db, &model.WebhookDelivery{}, &orm.DropTableOptions{IfExists: true}); err != nil {
+			return err
+		}
+
+		_, err := orm.DropTable(db, &model.WebhookSubscription{}, &orm.DropTableOptions{IfExists: true})
+		return err
+	})
+}
@@ -0,0 +1,17 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+	"github.com/go-pg/pg/orm"
+)
+
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		_, err := orm.CreateTable(db, &model.CapacityThreshold{}, &orm.CreateTableOptions{IfNotExists: true})
+		return err
+	}, func(db migrations.DB) error {
+		_, err := orm.DropTable(db, &model.CapacityThreshold{}, &orm.DropTableOptions{IfExists: true})
+		return err
+	})
+}
@@ -0,0 +1,18 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+)
+
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		_, err := db.Model(&model.Storage{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName" ADD COLUMN IF NOT EXISTS "synced" BOOLEAN NOT NULL DEFAULT FALSE;`)
+		return err
+	}, func(db migrations.DB) error {
+		_, err := db.Model(&model.Storage{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName" DROP COLUMN IF EXISTS "synced";`)
+		return err
+	})
+}
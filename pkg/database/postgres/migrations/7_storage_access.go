@@ -0,0 +1,30 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+)
+
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		_, err := db.Model(&model.Storage{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+						ADD COLUMN IF NOT EXISTS "allowed_namespaces" TEXT[],
+						ADD COLUMN IF NOT EXISTS "denied_namespaces" TEXT[],
+						ADD COLUMN IF NOT EXISTS "allowed_users" TEXT[],
+						ADD COLUMN IF NOT EXISTS "denied_users" TEXT[],
+						ADD COLUMN IF NOT EXISTS "allowed_roles" TEXT[],
+						ADD COLUMN IF NOT EXISTS "denied_roles" TEXT[];`)
+		return err
+	}, func(db migrations.DB) error {
+		_, err := db.Model(&model.Storage{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+						DROP COLUMN IF EXISTS "allowed_namespaces",
+						DROP COLUMN IF EXISTS "denied_namespaces",
+						DROP COLUMN IF EXISTS "allowed_users",
+						DROP COLUMN IF EXISTS "denied_users",
+						DROP COLUMN IF EXISTS "allowed_roles",
+						DROP COLUMN IF EXISTS "denied_roles";`)
+		return err
+	})
+}
@@ -0,0 +1,33 @@
+package migrations
+
+import (
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/migrations"
+)
+
+// capacities were stored in GiB before, in columns go-pg created for int fields (BIGINT)
+func init() {
+	migrations.Register(func(db migrations.DB) error {
+		if _, err := db.Model(&model.Storage{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+						ALTER COLUMN "size" TYPE BIGINT USING ("size"::BIGINT * 1073741824),
+						ALTER COLUMN "used" TYPE BIGINT USING ("used"::BIGINT * 1073741824);`); err != nil {
+			return err
+		}
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+						ALTER COLUMN "capacity" TYPE BIGINT USING ("capacity"::BIGINT * 1073741824);`)
+		return err
+	}, func(db migrations.DB) error {
+		if _, err := db.Model(&model.Storage{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+						ALTER COLUMN "size" TYPE BIGINT USING ceil("size" / 1073741824.0)::BIGINT,
+						ALTER COLUMN "used" TYPE BIGINT USING ceil("used" / 1073741824.0)::BIGINT;`); err != nil {
+			return err
+		}
+		_, err := db.Model(&model.Volume{}).Exec( /* language=sql */
+			`ALTER TABLE "?TableName"
+						ALTER COLUMN "capacity" TYPE BIGINT USING ceil("capacity" / 1073741824.0)::BIGINT;`)
+		return err
+	})
+}
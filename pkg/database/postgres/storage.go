@@ -6,6 +6,8 @@ import (
 	"git.containerum.net/ch/volume-manager/pkg/errors"
 	"git.containerum.net/ch/volume-manager/pkg/models"
 	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/sirupsen/logrus"
 )
 
 func (pgdb *PgDB) CreateStorage(ctx context.Context, storage *model.Storage) error {
@@ -22,6 +24,9 @@ func (pgdb *PgDB) CreateStorage(ctx context.Context, storage *model.Storage) err
 		_, err := pgdb.db.Model(storage).
 			Where("name = ?", storage.Name).
 			Set("size = ?size").
+			Set("attributes = ?attributes").
+			Apply(setStorageAccess).
+			Set("synced = ?synced").
 			Set("deleted = FALSE").
 			Update()
 		return pgdb.handleError(err)
@@ -61,6 +66,110 @@ func (pgdb *PgDB) AllStorages(ctx context.Context) (ret []model.Storage, err err
 	return
 }
 
+func (pgdb *PgDB) StorageUsageByNamespace(ctx context.Context, name string) (ret []model.NamespaceStorageUsage, err error) {
+	pgdb.log.WithField("name", name).Debugf("get storage usage by namespace")
+
+	ret = make([]model.NamespaceStorageUsage, 0)
+
+	err = pgdb.db.Model((*model.Volume)(nil)).
+		ColumnExpr("ns_id").
+		ColumnExpr("count(*) AS volumes").
+		ColumnExpr("sum(capacity) AS capacity").
+		Where("storage_name = ?", name).
+		Where("NOT deleted").
+		Group("ns_id").
+		OrderExpr("capacity DESC").
+		Select(&ret)
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+// NamespacesQuotaUsage counts only free volumes (zero tariff), paid volumes are not limited by namespace tariff
+func (pgdb *PgDB) NamespacesQuotaUsage(ctx context.Context, nsIDs ...string) (ret []model.NamespaceStorageUsage, err error) {
+	pgdb.log.WithField("ns_ids", nsIDs).Debugf("get namespaces quota usage")
+
+	ret = make([]model.NamespaceStorageUsage, 0)
+
+	q := pgdb.db.Model((*model.Volume)(nil)).
+		ColumnExpr("ns_id").
+		ColumnExpr("count(*) AS volumes").
+		ColumnExpr("sum(capacity) AS capacity").
+		Where("NOT deleted").
+		Where("tariff_id = uuid_nil()").
+		Group("ns_id")
+	if len(nsIDs) > 0 {
+		q = q.Where("ns_id IN (?)", pg.In(nsIDs))
+	}
+	err = q.Select(&ret)
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) StoragesUsageCheck(ctx context.Context) (ret []model.StorageUsageCheck, err error) {
+	pgdb.log.Debugf("check storages usage")
+
+	// lock storages to prevent usage changes by concurrent volume operations
+	var storages []model.Storage
+	err = pgdb.db.Model(&storages).
+		Where("NOT deleted").
+		For("UPDATE").
+		Select()
+	if err != nil {
+		return nil, pgdb.handleError(err)
+	}
+
+	ret = make([]model.StorageUsageCheck, 0)
+
+	err = pgdb.db.Model((*model.Storage)(nil)).
+		ColumnExpr("?TableAlias.name").
+		ColumnExpr("?TableAlias.used AS recorded").
+		ColumnExpr("coalesce(sum(vol.capacity), 0) AS actual").
+		Join("LEFT JOIN volumes AS vol ON vol.storage_name = ?TableAlias.name AND NOT vol.deleted").
+		Where("NOT ?TableAlias.deleted").
+		GroupExpr("?TableAlias.name, ?TableAlias.used").
+		OrderExpr("?TableAlias.name ASC").
+		Select(&ret)
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) SetStorageUsed(ctx context.Context, name string, used model.Quantity) error {
+	pgdb.log.WithFields(logrus.Fields{
+		"name": name,
+		"used": used,
+	}).Debugf("set storage used")
+
+	result, err := pgdb.db.Model(&model.Storage{Name: name}).
+		WherePK().
+		Set("used = ?", used).
+		Update()
+	if err != nil {
+		return pgdb.handleError(err)
+	}
+	if result.RowsAffected() <= 0 {
+		return errors.ErrResourceNotExists().AddDetailF("storage %s not exists", name)
+	}
+	return nil
+}
+
 func (pgdb *PgDB) UpdateStorage(ctx context.Context, name string, storage model.Storage) error {
 	pgdb.log.WithField("name", name).Debugf("update storage to %+v", storage)
 
@@ -80,6 +189,8 @@ func (pgdb *PgDB) UpdateStorage(ctx context.Context, name string, storage model.
 		Where("name = ?", name).
 		Set("name = ?name").
 		Set("size = ?size").
+		Set("attributes = ?attributes").
+		Apply(setStorageAccess).
 		Update()
 	if err != nil {
 		return pgdb.handleError(err)
@@ -120,20 +231,37 @@ func (pgdb *PgDB) DeleteStorage(ctx context.Context, storage *model.Storage) err
 	return nil
 }
 
-func (pgdb *PgDB) LeastUsedStorage(ctx context.Context, minFree int) (ret model.Storage, err error) {
-	pgdb.log.WithField("min_free", minFree).Debugf("get least used storage with constraint")
+func (pgdb *PgDB) LeastUsedStorage(ctx context.Context, minFree model.Quantity, consumer model.StorageConsumer) (ret model.Storage, err error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"min_free": minFree,
+		"ns_id":    consumer.NamespaceID,
+		"user_id":  consumer.UserID,
+	}).Debugf("get least used storage with constraint")
 
-	err = pgdb.db.Model(&ret).
+	var storages []model.Storage
+	err = pgdb.db.Model(&storages).
 		Where("size - used >= ?", minFree).
 		Where("NOT deleted").
 		OrderExpr("used ASC").
-		First()
-	switch err {
-	case pg.ErrNoRows:
-		err = errors.ErrNoFreeStorages()
-	default:
-		err = pgdb.handleError(err)
+		Select()
+	if err != nil && err != pg.ErrNoRows {
+		return ret, pgdb.handleError(err)
 	}
 
-	return
+	for _, storage := range storages {
+		if storage.Allows(consumer) {
+			return storage, nil
+		}
+	}
+
+	return ret, errors.ErrNoFreeStorages()
+}
+
+func setStorageAccess(q *orm.Query) (*orm.Query, error) {
+	return q.Set("allowed_namespaces = ?allowed_namespaces").
+		Set("denied_namespaces = ?denied_namespaces").
+		Set("allowed_users = ?allowed_users").
+		Set("denied_users = ?denied_users").
+		Set("allowed_roles = ?allowed_roles").
+		Set("denied_roles = ?denied_roles"), nil
 }
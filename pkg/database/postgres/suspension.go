@@ -0,0 +1,84 @@
+package postgres
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/sirupsen/logrus"
+)
+
+func (pgdb *PgDB) Suspensions(ctx context.Context) (ret []model.Suspension, err error) {
+	pgdb.log.Debugf("get suspensions")
+
+	ret = make([]model.Suspension, 0)
+	err = pgdb.db.Model(&ret).
+		Order("create_time").
+		Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+// AccountSuspensions returns suspensions of user and namespace
+func (pgdb *PgDB) AccountSuspensions(ctx context.Context, userID, nsID string) (ret []model.Suspension, err error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"ns_id":   nsID,
+	}).Debugf("get account suspensions")
+
+	ret = make([]model.Suspension, 0)
+	err = pgdb.db.Model(&ret).
+		WhereGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.Where("kind = ?", model.SuspendedUser).Where("id = ?", userID), nil
+		}).
+		WhereOrGroup(func(q *orm.Query) (*orm.Query, error) {
+			return q.Where("kind = ?", model.SuspendedNamespace).Where("id = ?", nsID), nil
+		}).
+		Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) SaveSuspension(ctx context.Context, suspension *model.Suspension) error {
+	pgdb.log.Debugf("save suspension %+v", suspension)
+
+	_, err := pgdb.db.Model(suspension).
+		OnConflict("(kind, id) DO UPDATE").
+		Set("source = EXCLUDED.source").
+		Set("reason = EXCLUDED.reason").
+		Returning("*").
+		Insert()
+	return pgdb.handleError(err)
+}
+
+func (pgdb *PgDB) DeleteSuspension(ctx context.Context, suspension *model.Suspension) error {
+	pgdb.log.Debugf("delete suspension %+v", suspension)
+
+	result, err := pgdb.db.Model(suspension).
+		WherePK().
+		Returning("*").
+		Delete()
+	if err != nil {
+		return pgdb.handleError(err)
+	}
+
+	if result.RowsAffected() <= 0 {
+		return errors.ErrResourceNotExists().AddDetailF("%s %s is not suspended", suspension.Kind, suspension.ID)
+	}
+
+	return nil
+}
@@ -1,21 +1,52 @@
 package postgres
 
 import (
+	"strings"
+
 	"git.containerum.net/ch/volume-manager/pkg/database"
 	"github.com/go-pg/pg/orm"
 )
 
 type VolumeFilter database.VolumeFilter
 
+// rangeOps whitelists operators inserted into query
+var rangeOps = map[string]bool{
+	database.OpEqual:        true,
+	database.OpGreater:      true,
+	database.OpGreaterEqual: true,
+	database.OpLess:         true,
+	database.OpLessEqual:    true,
+}
+
+// Filter applies conditions, order and pagination of filter
 func (f *VolumeFilter) Filter(q *orm.Query) (*orm.Query, error) {
-	if f.NotDeleted {
-		q = q.Where("NOT ?TableAlias.deleted")
+	q, err := f.Conditions(q)
+	if err != nil {
+		return q, err
 	}
-	if f.Deleted {
-		q = q.Where("?TableAlias.deleted")
+
+	if f.After != nil && len(f.Sort) == 0 {
+		q = q.Where("(?TableAlias.create_time, ?TableAlias.id) > (?, ?)", f.After.CreateTime, f.After.ID)
 	}
 
-	if f.PerPage > 0 {
+	for _, sort := range f.Sort {
+		order := "?TableAlias." + sort.Column
+		if sort.Desc {
+			order += " DESC NULLS LAST"
+		}
+		q = q.OrderExpr(order)
+	}
+	if len(f.Sort) == 0 {
+		// default order used by keyset pagination
+		q = q.OrderExpr("?TableAlias.create_time")
+	}
+	// stable order for pagination
+	q = q.OrderExpr("?TableAlias.id")
+
+	switch {
+	case f.PerPage > 0 && f.After != nil:
+		q = q.Limit(f.PerPage)
+	case f.PerPage > 0:
 		pager := orm.Pager{Limit: f.PerPage}
 		pager.SetPage(f.Page)
 		q = q.Apply(pager.Paginate)
@@ -23,3 +54,54 @@ func (f *VolumeFilter) Filter(q *orm.Query) (*orm.Query, error) {
 
 	return q, nil
 }
+
+// Conditions applies only conditions of filter, order and pagination are ignored
+func (f *VolumeFilter) Conditions(q *orm.Query) (*orm.Query, error) {
+	if f.NotDeleted {
+		q = q.Where("NOT ?TableAlias.deleted")
+	}
+	if f.Deleted {
+		q = q.Where("?TableAlias.deleted")
+	}
+	if f.StorageName != "" {
+		q = q.Where("?TableAlias.storage_name = ?", f.StorageName)
+	}
+	if f.TariffID != "" {
+		q = q.Where("?TableAlias.tariff_id = ?", f.TariffID)
+	}
+	if f.OwnerUserID != "" {
+		q = q.Where("?TableAlias.owner_user_id = ?", f.OwnerUserID)
+	}
+	if f.NamespaceID != "" {
+		q = q.Where("?TableAlias.ns_id = ?", f.NamespaceID)
+	}
+	if f.Cluster != "" {
+		q = q.Where("?TableAlias.cluster = ?", f.Cluster)
+	}
+	if f.LabelPrefix != "" {
+		q = q.Where("?TableAlias.label LIKE ?", escapeLike(f.LabelPrefix)+"%")
+	}
+	for _, cond := range f.Capacity {
+		if rangeOps[cond.Op] {
+			q = q.Where("?TableAlias.capacity "+cond.Op+" ?", cond.Value)
+		}
+	}
+	for _, cond := range f.CreateTime {
+		if rangeOps[cond.Op] {
+			q = q.Where("?TableAlias.create_time "+cond.Op+" ?", cond.Value)
+		}
+	}
+	for _, cond := range f.DeleteTime {
+		if rangeOps[cond.Op] {
+			q = q.Where("?TableAlias.delete_time "+cond.Op+" ?", cond.Value)
+		}
+	}
+
+	return q, nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -93,13 +93,30 @@ func (pgdb *PgDB) AllVolumes(ctx context.Context, filter database.VolumeFilter)
 	return
 }
 
+// CountVolumes returns number of volumes matching filter conditions, pagination is ignored
+func (pgdb *PgDB) CountVolumes(ctx context.Context, filter database.VolumeFilter) (int, error) {
+	pgdb.log.WithFields(logrus.Fields{
+		"filters": filter,
+	}).Debugf("count volumes")
+
+	f := VolumeFilter(filter)
+	count, err := pgdb.db.Model((*model.Volume)(nil)).
+		Apply(f.Conditions).
+		Count()
+	return count, pgdb.handleError(err)
+}
+
 func (pgdb *PgDB) CreateVolume(ctx context.Context, volume *model.Volume) error {
 	pgdb.log.Debugf("create volume %+v", volume)
 
 	_, err := pgdb.db.Model(volume).
 		Returning("*").
 		Insert()
-	return pgdb.handleError(err)
+	if err != nil {
+		return pgdb.handleError(err)
+	}
+
+	return pgdb.handleError(pgdb.recordMetering(*volume))
 }
 
 func (pgdb *PgDB) DeleteVolume(ctx context.Context, volume *model.Volume) error {
@@ -119,7 +136,7 @@ func (pgdb *PgDB) DeleteVolume(ctx context.Context, volume *model.Volume) error
 		return errors.ErrResourceNotExists().AddDetailF("volume %s not exists", volume.Label)
 	}
 
-	return nil
+	return pgdb.handleError(pgdb.recordMetering(*volume))
 }
 
 func (pgdb *PgDB) DeleteVolumes(ctx context.Context, volumes []model.Volume) error {
@@ -145,7 +162,7 @@ func (pgdb *PgDB) DeleteVolumes(ctx context.Context, volumes []model.Volume) err
 		return pgdb.handleError(err)
 	}
 
-	return nil
+	return pgdb.handleError(pgdb.recordMetering(volumes...))
 }
 
 func (pgdb *PgDB) UpdateVolume(ctx context.Context, volume *model.Volume) error {
@@ -153,10 +170,13 @@ func (pgdb *PgDB) UpdateVolume(ctx context.Context, volume *model.Volume) error
 
 	result, err := pgdb.db.Model(volume).
 		WherePK().
+		Set("label = ?label").
 		Set("tariff_id = ?tariff_id").
 		Set("capacity = ?capacity").
 		Set("ns_id = ?ns_id").
 		Set("access_mode = ?access_mode").
+		Set("suspended = ?suspended").
+		Set("metadata = ?metadata").
 		Returning("*").
 		Update()
 	if err != nil {
@@ -167,5 +187,29 @@ func (pgdb *PgDB) UpdateVolume(ctx context.Context, volume *model.Volume) error
 		return errors.ErrResourceNotExists().AddDetailF("volume %s not exists", volume.Label)
 	}
 
+	return pgdb.handleError(pgdb.recordMetering(*volume))
+}
+
+// SetVolumeStatus updates status columns only, so storage usage hooks are not triggered
+func (pgdb *PgDB) SetVolumeStatus(ctx context.Context, volume *model.Volume, status model.VolumeStatusUpdate) error {
+	pgdb.log.WithFields(logrus.Fields{
+		"volume_id": volume.ID,
+		"status":    status.Status,
+	}).Debugf("set volume status")
+
+	result, err := pgdb.db.Model(volume).Exec( /* language=sql */
+		`UPDATE "?TableName" SET "status" = ?, "bound_time" = ?, "status_reason" = ? WHERE "id" = ?id AND NOT "deleted"`,
+		status.Status, status.BoundTime, status.Reason)
+	if err != nil {
+		return pgdb.handleError(err)
+	}
+
+	if result.RowsAffected() <= 0 {
+		return errors.ErrResourceNotExists().AddDetailF("volume %s not exists", volume.Label)
+	}
+
+	volume.Status = status.Status
+	volume.BoundTime = status.BoundTime
+	volume.StatusReason = status.Reason
 	return nil
 }
@@ -0,0 +1,162 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/go-pg/pg"
+	"github.com/go-pg/pg/orm"
+	"github.com/sirupsen/logrus"
+)
+
+func (pgdb *PgDB) WebhookSubscriptions(ctx context.Context) (ret []model.WebhookSubscription, err error) {
+	pgdb.log.Debugf("get webhook subscriptions")
+
+	ret = make([]model.WebhookSubscription, 0)
+	err = pgdb.db.Model(&ret).
+		Order("create_time").
+		Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) CreateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error {
+	pgdb.log.WithFields(logrus.Fields{
+		"url":    subscription.URL,
+		"events": subscription.Events,
+	}).Debugf("create webhook subscription")
+
+	_, err := pgdb.db.Model(subscription).
+		Returning("*").
+		Insert()
+	return pgdb.handleError(err)
+}
+
+func (pgdb *PgDB) DeleteWebhookSubscription(ctx context.Context, id string) error {
+	pgdb.log.WithField("id", id).Debugf("delete webhook subscription")
+
+	result, err := pgdb.db.Model(&model.WebhookSubscription{ID: id}).
+		WherePK().
+		Delete()
+	if err != nil {
+		return pgdb.handleError(err)
+	}
+
+	if result.RowsAffected() <= 0 {
+		return errors.ErrResourceNotExists().AddDetailF("webhook subscription %s not exists", id)
+	}
+
+	return nil
+}
+
+func (pgdb *PgDB) CreateWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	pgdb.log.WithField("count", len(deliveries)).Debugf("create webhook deliveries")
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	_, err := pgdb.db.Model(&deliveries).
+		Returning("*").
+		Insert()
+	return pgdb.handleError(err)
+}
+
+// ClaimWebhookDeliveries selects pending deliveries due at now and postpones their next attempt by lease,
+// so concurrent workers do not send the same delivery.
+func (pgdb *PgDB) ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) (ret []model.WebhookDelivery, err error) {
+	pgdb.log.WithField("limit", limit).Debugf("claim webhook deliveries")
+
+	ret = make([]model.WebhookDelivery, 0)
+	_, err = pgdb.db.Model(&ret).Query(&ret, /* language=sql */
+		`UPDATE "?TableName" SET "next_attempt_time" = ?
+		WHERE "id" IN (
+			SELECT "id" FROM "?TableName"
+			WHERE "status" = ? AND "next_attempt_time" <= ?
+			ORDER BY "next_attempt_time"
+			LIMIT ?
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING *`,
+		now.Add(lease), model.WebhookDeliveryPending, now, limit)
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	pgdb.log.WithFields(logrus.Fields{
+		"id":       delivery.ID,
+		"status":   delivery.Status,
+		"attempts": delivery.Attempts,
+	}).Debugf("update webhook delivery")
+
+	_, err := pgdb.db.Model(delivery).
+		WherePK().
+		Set("status = ?status").
+		Set("attempts = ?attempts").
+		Set("response_status = ?response_status").
+		Set("error = ?error").
+		Set("next_attempt_time = ?next_attempt_time").
+		Set("delivered_time = ?delivered_time").
+		Update()
+	return pgdb.handleError(err)
+}
+
+func (pgdb *PgDB) WebhookDeliveries(ctx context.Context, filter database.WebhookDeliveryFilter) (ret []model.WebhookDelivery, err error) {
+	pgdb.log.WithField("filter", filter).Debugf("get webhook deliveries")
+
+	ret = make([]model.WebhookDelivery, 0)
+	q := pgdb.db.Model(&ret).
+		Apply(webhookDeliveryConditions(filter)).
+		Order("create_time DESC", "id")
+	if filter.PerPage > 0 {
+		pager := orm.Pager{Limit: filter.PerPage}
+		pager.SetPage(filter.Page)
+		q = q.Apply(pager.Paginate)
+	}
+	err = q.Select()
+	switch err {
+	case pg.ErrNoRows:
+		err = nil
+	default:
+		err = pgdb.handleError(err)
+	}
+
+	return
+}
+
+func (pgdb *PgDB) CountWebhookDeliveries(ctx context.Context, filter database.WebhookDeliveryFilter) (int, error) {
+	pgdb.log.WithField("filter", filter).Debugf("count webhook deliveries")
+
+	count, err := pgdb.db.Model((*model.WebhookDelivery)(nil)).
+		Apply(webhookDeliveryConditions(filter)).
+		Count()
+	return count, pgdb.handleError(err)
+}
+
+func webhookDeliveryConditions(filter database.WebhookDeliveryFilter) func(q *orm.Query) (*orm.Query, error) {
+	return func(q *orm.Query) (*orm.Query, error) {
+		if filter.SubscriptionID != "" {
+			q = q.Where("subscription_id = ?", filter.SubscriptionID)
+		}
+		if filter.Status != "" {
+			q = q.Where("status = ?", filter.Status)
+		}
+		return q, nil
+	}
+}
@@ -3,27 +3,66 @@ package database
 import (
 	"context"
 	"io"
+	"time"
 
 	"git.containerum.net/ch/volume-manager/pkg/models"
 )
 
 type DB interface {
 	StorageByName(ctx context.Context, name string) (model.Storage, error)
-	LeastUsedStorage(ctx context.Context, requestSize int) (model.Storage, error)
+	LeastUsedStorage(ctx context.Context, requestSize model.Quantity, consumer model.StorageConsumer) (model.Storage, error)
 	AllStorages(ctx context.Context) ([]model.Storage, error)
+	StorageUsageByNamespace(ctx context.Context, name string) ([]model.NamespaceStorageUsage, error)
+	NamespacesQuotaUsage(ctx context.Context, nsIDs ...string) ([]model.NamespaceStorageUsage, error)
+	StoragesUsageCheck(ctx context.Context) ([]model.StorageUsageCheck, error)
+	SetStorageUsed(ctx context.Context, name string, used model.Quantity) error
 	CreateStorage(ctx context.Context, storage *model.Storage) error
 	UpdateStorage(ctx context.Context, name string, storage model.Storage) error
 	DeleteStorage(ctx context.Context, storage *model.Storage) error
 
+	SaveUsageSnapshots(ctx context.Context, storages []model.StorageUsageSnapshot, namespaces []model.NamespaceUsageSnapshot) error
+	StorageUsageHistory(ctx context.Context, name string, since time.Time) ([]model.StorageUsageSnapshot, error)
+	NamespaceUsageHistory(ctx context.Context, name string, since time.Time) ([]model.NamespaceUsageSnapshot, error)
+
+	MeteringPeriods(ctx context.Context, from, to time.Time) ([]model.MeteringPeriod, error)
+
+	Suspensions(ctx context.Context) ([]model.Suspension, error)
+	AccountSuspensions(ctx context.Context, userID, nsID string) ([]model.Suspension, error)
+	SaveSuspension(ctx context.Context, suspension *model.Suspension) error
+	DeleteSuspension(ctx context.Context, suspension *model.Suspension) error
+
 	VolumeByLabel(ctx context.Context, nsID string, label string) (model.Volume, error)
 	UserVolumes(ctx context.Context, userID string) ([]model.Volume, error)
 	NamespaceVolumes(ctx context.Context, nsID string) ([]model.Volume, error)
 	AllVolumes(ctx context.Context, filter VolumeFilter) ([]model.Volume, error)
+	CountVolumes(ctx context.Context, filter VolumeFilter) (int, error)
 	CreateVolume(ctx context.Context, volume *model.Volume) error
 	DeleteVolume(ctx context.Context, volume *model.Volume) error
 	DeleteVolumes(ctx context.Context, volumes []model.Volume) error
 	UpdateVolume(ctx context.Context, volume *model.Volume) error
+	SetVolumeStatus(ctx context.Context, volume *model.Volume, status model.VolumeStatusUpdate) error
+
+	WebhookSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error)
+	CreateWebhookSubscription(ctx context.Context, subscription *model.WebhookSubscription) error
+	DeleteWebhookSubscription(ctx context.Context, id string) error
+	CreateWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error
+	ClaimWebhookDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]model.WebhookDelivery, error)
+	UpdateWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	WebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter) ([]model.WebhookDelivery, error)
+	CountWebhookDeliveries(ctx context.Context, filter WebhookDeliveryFilter) (int, error)
+
+	RaiseCapacityThreshold(ctx context.Context, threshold *model.CapacityThreshold) (bool, error)
 
 	Transactional(func(tx DB) error) error
 	io.Closer
 }
+
+// WebhookDeliveryFilter selects webhook deliveries, newest first
+type WebhookDeliveryFilter struct {
+	Page    int
+	PerPage int
+
+	SubscriptionID string
+
+	Status model.WebhookDeliveryStatus
+}
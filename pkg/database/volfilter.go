@@ -1,13 +1,82 @@
 package database
 
-import "reflect"
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
 
 type VolumeFilter struct {
 	Page    int
 	PerPage int
 
+	// Keyset pagination position, volumes created after it are selected.
+	// Used only with default order by create time and id, Page is ignored if set.
+	After *model.VolumeCursor
+
+	StorageName string
+
+	TariffID string
+
+	OwnerUserID string
+
+	NamespaceID string
+
+	Cluster string
+
+	LabelPrefix string
+
+	Capacity   []QuantityCondition
+	CreateTime []TimeCondition
+	DeleteTime []TimeCondition
+
 	NotDeleted bool `filter:"not_deleted"`
 	Deleted    bool `filter:"deleted"`
+
+	Sort []VolumeSort
+}
+
+// Comparison operators allowed in range conditions
+const (
+	OpEqual        = "="
+	OpGreater      = ">"
+	OpGreaterEqual = ">="
+	OpLess         = "<"
+	OpLessEqual    = "<="
+)
+
+type QuantityCondition struct {
+	Op    string
+	Value model.Quantity
+}
+
+type TimeCondition struct {
+	Op    string
+	Value time.Time
+}
+
+// VolumeSort is a sort key of volumes list
+type VolumeSort struct {
+	// Column name
+	Column string
+	Desc   bool
+}
+
+// VolumeSortFields maps sort field names accepted from users to columns
+var VolumeSortFields = map[string]string{
+	"create_time": "create_time",
+	"delete_time": "delete_time",
+	"capacity":    "capacity",
+	"label":       "label",
+	"storage":     "storage_name",
+	"namespace":   "ns_id",
+	"owner":       "owner_user_id",
+	"tariff":      "tariff_id",
+	"cluster":     "cluster",
 }
 
 var volFilterCache = make(map[string]int)
@@ -23,13 +92,114 @@ func init() {
 	}
 }
 
-func ParseVolumeFilter(filters ...string) VolumeFilter {
+var volFilterTermRegexp = regexp.MustCompile(`^([a-z_]+)(>=|<=|>|<|:|=)(.+)$`)
+
+// ParseVolumeFilter parses filter terms. Term is either a flag ("deleted", "not_deleted")
+// or a condition "name<op>value" where op is ":" or "=" for equality, or one of ">", ">=", "<", "<=" for ranges:
+//
+//	owner:<user id>, namespace:<namespace id>, storage:<name>, tariff:<tariff id>, cluster:<name>, label_prefix:<prefix>,
+//	capacity>=10Gi, create_time>=2018-01-01T00:00:00Z, delete_time<2018-02-01T00:00:00Z.
+//
+// Unknown filters and malformed values are rejected.
+// Only not deleted volumes are selected unless "deleted" flag or delete time condition present.
+func ParseVolumeFilter(filters ...string) (VolumeFilter, error) {
 	var ret VolumeFilter
 	v := reflect.ValueOf(&ret).Elem()
 	for _, filter := range filters {
+		filter = strings.TrimSpace(filter)
+		if filter == "" {
+			continue
+		}
 		if field, ok := volFilterCache[filter]; ok {
 			v.Field(field).SetBool(true)
+			continue
+		}
+
+		match := volFilterTermRegexp.FindStringSubmatch(filter)
+		if match == nil {
+			return ret, fmt.Errorf("unknown filter %q", filter)
+		}
+		name, op, value := match[1], match[2], match[3]
+		if op == ":" {
+			op = OpEqual
+		}
+		if err := ret.addCondition(name, op, value); err != nil {
+			return ret, fmt.Errorf("filter %q: %v", filter, err)
+		}
+	}
+
+	if ret.NotDeleted && ret.Deleted {
+		return ret, fmt.Errorf("filters \"deleted\" and \"not_deleted\" are mutually exclusive")
+	}
+	if !ret.Deleted && len(ret.DeleteTime) == 0 {
+		ret.NotDeleted = true
+	}
+	return ret, nil
+}
+
+func (f *VolumeFilter) addCondition(name, op, value string) error {
+	equality := func(target *string) error {
+		if op != OpEqual {
+			return fmt.Errorf("only equality allowed")
+		}
+		*target = value
+		return nil
+	}
+	switch name {
+	case "owner":
+		return equality(&f.OwnerUserID)
+	case "namespace":
+		return equality(&f.NamespaceID)
+	case "storage":
+		return equality(&f.StorageName)
+	case "tariff":
+		return equality(&f.TariffID)
+	case "cluster":
+		return equality(&f.Cluster)
+	case "label_prefix":
+		return equality(&f.LabelPrefix)
+	case "capacity":
+		quantity, err := model.ParseQuantity(value)
+		if err != nil {
+			return err
+		}
+		f.Capacity = append(f.Capacity, QuantityCondition{Op: op, Value: quantity})
+	case "create_time", "delete_time":
+		t, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return fmt.Errorf("time is not in RFC3339 format")
+		}
+		if name == "create_time" {
+			f.CreateTime = append(f.CreateTime, TimeCondition{Op: op, Value: t})
+		} else {
+			f.DeleteTime = append(f.DeleteTime, TimeCondition{Op: op, Value: t})
+		}
+	default:
+		return fmt.Errorf("unknown filter")
+	}
+	return nil
+}
+
+// ParseVolumeSort parses sort fields. Field prefixed with "-" sorts in descending order.
+func ParseVolumeSort(fields ...string) ([]VolumeSort, error) {
+	ret := make([]VolumeSort, 0, len(fields))
+	seen := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		desc := strings.HasPrefix(field, "-")
+		name := strings.TrimPrefix(field, "-")
+		column, ok := VolumeSortFields[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown sort field %q", name)
+		}
+		if seen[column] {
+			return nil, fmt.Errorf("sort field %q used twice", name)
 		}
+		seen[column] = true
+		ret = append(ret, VolumeSort{Column: column, Desc: desc})
 	}
-	return ret
+	return ret, nil
 }
@@ -0,0 +1,58 @@
+package database
+
+import (
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+func TestParseVolumeFilter(t *testing.T) {
+	filter, err := ParseVolumeFilter("owner:user", "namespace=ns", "label_prefix:db-", "capacity>=1Gi", "capacity<10Gi", "create_time>2018-01-01T00:00:00Z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter.OwnerUserID != "user" || filter.NamespaceID != "ns" || filter.LabelPrefix != "db-" {
+		t.Errorf("unexpected equality filters %+v", filter)
+	}
+	if len(filter.Capacity) != 2 ||
+		filter.Capacity[0] != (QuantityCondition{Op: OpGreaterEqual, Value: model.GiB}) ||
+		filter.Capacity[1] != (QuantityCondition{Op: OpLess, Value: 10 * model.GiB}) {
+		t.Errorf("unexpected capacity conditions %+v", filter.Capacity)
+	}
+	if len(filter.CreateTime) != 1 || filter.CreateTime[0].Op != OpGreater || !filter.CreateTime[0].Value.Equal(time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected create time conditions %+v", filter.CreateTime)
+	}
+	if !filter.NotDeleted {
+		t.Errorf("expected not deleted volumes by default")
+	}
+
+	if filter, err := ParseVolumeFilter("deleted"); err != nil || !filter.Deleted || filter.NotDeleted {
+		t.Errorf("unexpected deleted filter %+v, error %v", filter, err)
+	}
+
+	for _, bad := range []string{"unknown", "unknown:x", "owner>x", "capacity>=lots", "create_time<yesterday"} {
+		if _, err := ParseVolumeFilter(bad); err == nil {
+			t.Errorf("expected error for filter %q", bad)
+		}
+	}
+	if _, err := ParseVolumeFilter("deleted", "not_deleted"); err == nil {
+		t.Errorf("expected error for mutually exclusive filters")
+	}
+}
+
+func TestParseVolumeSort(t *testing.T) {
+	sort, err := ParseVolumeSort("-capacity", "label")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sort) != 2 || sort[0] != (VolumeSort{Column: "capacity", Desc: true}) || sort[1] != (VolumeSort{Column: "label"}) {
+		t.Errorf("unexpected sort %+v", sort)
+	}
+	if _, err := ParseVolumeSort("size"); err == nil {
+		t.Errorf("expected error for unknown sort field")
+	}
+	if _, err := ParseVolumeSort("label", "-label"); err == nil {
+		t.Errorf("expected error for duplicated sort field")
+	}
+}
@@ -145,6 +145,48 @@ func ErrDownResize(params ...func(*cherry.Err)) *cherry.Err {
 	}
 	return err
 }
+
+// ErrNotSupported error
+// Operation is not supported by dependent service or kube backend
+func ErrNotSupported(params ...func(*cherry.Err)) *cherry.Err {
+	err := &cherry.Err{Message: "Operation is not supported", StatusHTTP: 501, ID: cherry.ErrID{SID: "volume-manager", Kind: 0xc}, Details: []string(nil), Fields: cherry.Fields(nil)}
+	for _, param := range params {
+		param(err)
+	}
+	for i, detail := range err.Details {
+		det := renderTemplate(detail)
+		err.Details[i] = det
+	}
+	return err
+}
+
+// ErrServiceUnavailable error
+// Circuit breaker of dependent service client is open
+func ErrServiceUnavailable(params ...func(*cherry.Err)) *cherry.Err {
+	err := &cherry.Err{Message: "Dependent service temporarily unavailable", StatusHTTP: 503, ID: cherry.ErrID{SID: "volume-manager", Kind: 0xd}, Details: []string(nil), Fields: cherry.Fields(nil)}
+	for _, param := range params {
+		param(err)
+	}
+	for i, detail := range err.Details {
+		det := renderTemplate(detail)
+		err.Details[i] = det
+	}
+	return err
+}
+
+// ErrSuspended error
+// Volume, its owner or namespace is suspended, e.g. for unpaid account
+func ErrSuspended(params ...func(*cherry.Err)) *cherry.Err {
+	err := &cherry.Err{Message: "Volume is suspended", StatusHTTP: 403, ID: cherry.ErrID{SID: "volume-manager", Kind: 0xe}, Details: []string(nil), Fields: cherry.Fields(nil)}
+	for _, param := range params {
+		param(err)
+	}
+	for i, detail := range err.Details {
+		det := renderTemplate(detail)
+		err.Details[i] = det
+	}
+	return err
+}
 func renderTemplate(templText string) string {
 	buf := &bytes.Buffer{}
 	templ, err := template.New("").Parse(templText)
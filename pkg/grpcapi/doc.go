@@ -0,0 +1,5 @@
+// Package grpcapi contains gRPC API of volume-manager generated from proto/volume_manager.proto.
+// Code is generated with protoc-gen-go v1.2.0 matching vendored github.com/golang/protobuf.
+package grpcapi
+
+//go:generate protoc -I ../../proto --go_out=plugins=grpc:. volume_manager.proto
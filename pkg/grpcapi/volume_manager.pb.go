@@ -0,0 +1,4742 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: volume_manager.proto
+
+package grpcapi
+
+import proto "github.com/golang/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+import wrappers "github.com/golang/protobuf/ptypes/wrappers"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+// Error is a cherry error passed in status details
+type Error struct {
+	IdKind               uint64            `protobuf:"varint,1,opt,name=id_kind,json=idKind,proto3" json:"id_kind,omitempty"`
+	IdService            string            `protobuf:"bytes,2,opt,name=id_service,json=idService,proto3" json:"id_service,omitempty"`
+	Message              string            `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Details              []string          `protobuf:"bytes,4,rep,name=details,proto3" json:"details,omitempty"`
+	StatusHttp           int32             `protobuf:"varint,5,opt,name=status_http,json=statusHttp,proto3" json:"status_http,omitempty"`
+	Fields               map[string]string `protobuf:"bytes,6,rep,name=fields,proto3" json:"fields,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Error) Reset()         { *m = Error{} }
+func (m *Error) String() string { return proto.CompactTextString(m) }
+func (*Error) ProtoMessage()    {}
+func (*Error) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{0}
+}
+func (m *Error) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Error.Unmarshal(m, b)
+}
+func (m *Error) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Error.Marshal(b, m, deterministic)
+}
+func (dst *Error) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Error.Merge(dst, src)
+}
+func (m *Error) XXX_Size() int {
+	return xxx_messageInfo_Error.Size(m)
+}
+func (m *Error) XXX_DiscardUnknown() {
+	xxx_messageInfo_Error.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Error proto.InternalMessageInfo
+
+func (m *Error) GetIdKind() uint64 {
+	if m != nil {
+		return m.IdKind
+	}
+	return 0
+}
+
+func (m *Error) GetIdService() string {
+	if m != nil {
+		return m.IdService
+	}
+	return ""
+}
+
+func (m *Error) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *Error) GetDetails() []string {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
+func (m *Error) GetStatusHttp() int32 {
+	if m != nil {
+		return m.StatusHttp
+	}
+	return 0
+}
+
+func (m *Error) GetFields() map[string]string {
+	if m != nil {
+		return m.Fields
+	}
+	return nil
+}
+
+type PageRequest struct {
+	Page                 int32    `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              int32    `protobuf:"varint,2,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	Cursor               string   `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PageRequest) Reset()         { *m = PageRequest{} }
+func (m *PageRequest) String() string { return proto.CompactTextString(m) }
+func (*PageRequest) ProtoMessage()    {}
+func (*PageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{1}
+}
+func (m *PageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PageRequest.Unmarshal(m, b)
+}
+func (m *PageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PageRequest.Marshal(b, m, deterministic)
+}
+func (dst *PageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PageRequest.Merge(dst, src)
+}
+func (m *PageRequest) XXX_Size() int {
+	return xxx_messageInfo_PageRequest.Size(m)
+}
+func (m *PageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PageRequest proto.InternalMessageInfo
+
+func (m *PageRequest) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *PageRequest) GetPerPage() int32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+func (m *PageRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+type Pagination struct {
+	Total                int32    `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Page                 int32    `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PerPage              int32    `protobuf:"varint,3,opt,name=per_page,json=perPage,proto3" json:"per_page,omitempty"`
+	NextCursor           string   `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Pagination) Reset()         { *m = Pagination{} }
+func (m *Pagination) String() string { return proto.CompactTextString(m) }
+func (*Pagination) ProtoMessage()    {}
+func (*Pagination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{2}
+}
+func (m *Pagination) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Pagination.Unmarshal(m, b)
+}
+func (m *Pagination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Pagination.Marshal(b, m, deterministic)
+}
+func (dst *Pagination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pagination.Merge(dst, src)
+}
+func (m *Pagination) XXX_Size() int {
+	return xxx_messageInfo_Pagination.Size(m)
+}
+func (m *Pagination) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pagination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pagination proto.InternalMessageInfo
+
+func (m *Pagination) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Pagination) GetPage() int32 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *Pagination) GetPerPage() int32 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+func (m *Pagination) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+// Volume mirrors VolumeResponse REST model
+type Volume struct {
+	Label                string               `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,2,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	TariffId             string               `protobuf:"bytes,3,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	Capacity             int64                `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	StorageName          string               `protobuf:"bytes,5,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	AccessMode           string               `protobuf:"bytes,6,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	Status               string               `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Cluster              string               `protobuf:"bytes,8,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	Metadata             map[string]string    `protobuf:"bytes,9,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Volume) Reset()         { *m = Volume{} }
+func (m *Volume) String() string { return proto.CompactTextString(m) }
+func (*Volume) ProtoMessage()    {}
+func (*Volume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{3}
+}
+func (m *Volume) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Volume.Unmarshal(m, b)
+}
+func (m *Volume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Volume.Marshal(b, m, deterministic)
+}
+func (dst *Volume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Volume.Merge(dst, src)
+}
+func (m *Volume) XXX_Size() int {
+	return xxx_messageInfo_Volume.Size(m)
+}
+func (m *Volume) XXX_DiscardUnknown() {
+	xxx_messageInfo_Volume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Volume proto.InternalMessageInfo
+
+func (m *Volume) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *Volume) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *Volume) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *Volume) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *Volume) GetStorageName() string {
+	if m != nil {
+		return m.StorageName
+	}
+	return ""
+}
+
+func (m *Volume) GetAccessMode() string {
+	if m != nil {
+		return m.AccessMode
+	}
+	return ""
+}
+
+func (m *Volume) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *Volume) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+func (m *Volume) GetMetadata() map[string]string {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+type VolumeRef struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VolumeRef) Reset()         { *m = VolumeRef{} }
+func (m *VolumeRef) String() string { return proto.CompactTextString(m) }
+func (*VolumeRef) ProtoMessage()    {}
+func (*VolumeRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{4}
+}
+func (m *VolumeRef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeRef.Unmarshal(m, b)
+}
+func (m *VolumeRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeRef.Marshal(b, m, deterministic)
+}
+func (dst *VolumeRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeRef.Merge(dst, src)
+}
+func (m *VolumeRef) XXX_Size() int {
+	return xxx_messageInfo_VolumeRef.Size(m)
+}
+func (m *VolumeRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeRef proto.InternalMessageInfo
+
+func (m *VolumeRef) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *VolumeRef) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type CreateVolumeRequest struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TariffId             string   `protobuf:"bytes,2,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	Storage              string   `protobuf:"bytes,4,opt,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateVolumeRequest) Reset()         { *m = CreateVolumeRequest{} }
+func (m *CreateVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateVolumeRequest) ProtoMessage()    {}
+func (*CreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{5}
+}
+func (m *CreateVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateVolumeRequest.Unmarshal(m, b)
+}
+func (m *CreateVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateVolumeRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateVolumeRequest.Merge(dst, src)
+}
+func (m *CreateVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateVolumeRequest.Size(m)
+}
+func (m *CreateVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateVolumeRequest proto.InternalMessageInfo
+
+func (m *CreateVolumeRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *CreateVolumeRequest) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *CreateVolumeRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *CreateVolumeRequest) GetStorage() string {
+	if m != nil {
+		return m.Storage
+	}
+	return ""
+}
+
+type DirectCreateVolumeRequest struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Storage              string   `protobuf:"bytes,4,opt,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DirectCreateVolumeRequest) Reset()         { *m = DirectCreateVolumeRequest{} }
+func (m *DirectCreateVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*DirectCreateVolumeRequest) ProtoMessage()    {}
+func (*DirectCreateVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{6}
+}
+func (m *DirectCreateVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DirectCreateVolumeRequest.Unmarshal(m, b)
+}
+func (m *DirectCreateVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DirectCreateVolumeRequest.Marshal(b, m, deterministic)
+}
+func (dst *DirectCreateVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DirectCreateVolumeRequest.Merge(dst, src)
+}
+func (m *DirectCreateVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_DirectCreateVolumeRequest.Size(m)
+}
+func (m *DirectCreateVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DirectCreateVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DirectCreateVolumeRequest proto.InternalMessageInfo
+
+func (m *DirectCreateVolumeRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *DirectCreateVolumeRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *DirectCreateVolumeRequest) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *DirectCreateVolumeRequest) GetStorage() string {
+	if m != nil {
+		return m.Storage
+	}
+	return ""
+}
+
+type ResizeVolumeRequest struct {
+	Volume               *VolumeRef `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	TariffId             string     `protobuf:"bytes,2,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ResizeVolumeRequest) Reset()         { *m = ResizeVolumeRequest{} }
+func (m *ResizeVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*ResizeVolumeRequest) ProtoMessage()    {}
+func (*ResizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{7}
+}
+func (m *ResizeVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResizeVolumeRequest.Unmarshal(m, b)
+}
+func (m *ResizeVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResizeVolumeRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResizeVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResizeVolumeRequest.Merge(dst, src)
+}
+func (m *ResizeVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_ResizeVolumeRequest.Size(m)
+}
+func (m *ResizeVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResizeVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResizeVolumeRequest proto.InternalMessageInfo
+
+func (m *ResizeVolumeRequest) GetVolume() *VolumeRef {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *ResizeVolumeRequest) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+type AdminResizeVolumeRequest struct {
+	Volume               *VolumeRef `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	Capacity             int64      `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *AdminResizeVolumeRequest) Reset()         { *m = AdminResizeVolumeRequest{} }
+func (m *AdminResizeVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*AdminResizeVolumeRequest) ProtoMessage()    {}
+func (*AdminResizeVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{8}
+}
+func (m *AdminResizeVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdminResizeVolumeRequest.Unmarshal(m, b)
+}
+func (m *AdminResizeVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdminResizeVolumeRequest.Marshal(b, m, deterministic)
+}
+func (dst *AdminResizeVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdminResizeVolumeRequest.Merge(dst, src)
+}
+func (m *AdminResizeVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_AdminResizeVolumeRequest.Size(m)
+}
+func (m *AdminResizeVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdminResizeVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdminResizeVolumeRequest proto.InternalMessageInfo
+
+func (m *AdminResizeVolumeRequest) GetVolume() *VolumeRef {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *AdminResizeVolumeRequest) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+// PatchVolumeRequest carries JSON merge patch (RFC 7386) of volume, see VolumePatch REST model
+type PatchVolumeRequest struct {
+	Volume               *VolumeRef `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	MergePatch           []byte     `protobuf:"bytes,2,opt,name=merge_patch,json=mergePatch,proto3" json:"merge_patch,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *PatchVolumeRequest) Reset()         { *m = PatchVolumeRequest{} }
+func (m *PatchVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*PatchVolumeRequest) ProtoMessage()    {}
+func (*PatchVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{9}
+}
+func (m *PatchVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PatchVolumeRequest.Unmarshal(m, b)
+}
+func (m *PatchVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PatchVolumeRequest.Marshal(b, m, deterministic)
+}
+func (dst *PatchVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PatchVolumeRequest.Merge(dst, src)
+}
+func (m *PatchVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_PatchVolumeRequest.Size(m)
+}
+func (m *PatchVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PatchVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PatchVolumeRequest proto.InternalMessageInfo
+
+func (m *PatchVolumeRequest) GetVolume() *VolumeRef {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *PatchVolumeRequest) GetMergePatch() []byte {
+	if m != nil {
+		return m.MergePatch
+	}
+	return nil
+}
+
+type ListNamespaceVolumesRequest struct {
+	NamespaceId          string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Page                 *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListNamespaceVolumesRequest) Reset()         { *m = ListNamespaceVolumesRequest{} }
+func (m *ListNamespaceVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNamespaceVolumesRequest) ProtoMessage()    {}
+func (*ListNamespaceVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{10}
+}
+func (m *ListNamespaceVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNamespaceVolumesRequest.Unmarshal(m, b)
+}
+func (m *ListNamespaceVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNamespaceVolumesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListNamespaceVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamespaceVolumesRequest.Merge(dst, src)
+}
+func (m *ListNamespaceVolumesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNamespaceVolumesRequest.Size(m)
+}
+func (m *ListNamespaceVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamespaceVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamespaceVolumesRequest proto.InternalMessageInfo
+
+func (m *ListNamespaceVolumesRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ListNamespaceVolumesRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type ListUserVolumesRequest struct {
+	Page                 *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListUserVolumesRequest) Reset()         { *m = ListUserVolumesRequest{} }
+func (m *ListUserVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUserVolumesRequest) ProtoMessage()    {}
+func (*ListUserVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{11}
+}
+func (m *ListUserVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserVolumesRequest.Unmarshal(m, b)
+}
+func (m *ListUserVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserVolumesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListUserVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserVolumesRequest.Merge(dst, src)
+}
+func (m *ListUserVolumesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUserVolumesRequest.Size(m)
+}
+func (m *ListUserVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserVolumesRequest proto.InternalMessageInfo
+
+func (m *ListUserVolumesRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type ListAllVolumesRequest struct {
+	Page                 *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Sort                 []string     `protobuf:"bytes,2,rep,name=sort,proto3" json:"sort,omitempty"`
+	Filters              []string     `protobuf:"bytes,3,rep,name=filters,proto3" json:"filters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListAllVolumesRequest) Reset()         { *m = ListAllVolumesRequest{} }
+func (m *ListAllVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAllVolumesRequest) ProtoMessage()    {}
+func (*ListAllVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{12}
+}
+func (m *ListAllVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAllVolumesRequest.Unmarshal(m, b)
+}
+func (m *ListAllVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAllVolumesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListAllVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAllVolumesRequest.Merge(dst, src)
+}
+func (m *ListAllVolumesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAllVolumesRequest.Size(m)
+}
+func (m *ListAllVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAllVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAllVolumesRequest proto.InternalMessageInfo
+
+func (m *ListAllVolumesRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+func (m *ListAllVolumesRequest) GetSort() []string {
+	if m != nil {
+		return m.Sort
+	}
+	return nil
+}
+
+func (m *ListAllVolumesRequest) GetFilters() []string {
+	if m != nil {
+		return m.Filters
+	}
+	return nil
+}
+
+type VolumesResponse struct {
+	Volumes              []*Volume   `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Pagination           *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *VolumesResponse) Reset()         { *m = VolumesResponse{} }
+func (m *VolumesResponse) String() string { return proto.CompactTextString(m) }
+func (*VolumesResponse) ProtoMessage()    {}
+func (*VolumesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{13}
+}
+func (m *VolumesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumesResponse.Unmarshal(m, b)
+}
+func (m *VolumesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumesResponse.Marshal(b, m, deterministic)
+}
+func (dst *VolumesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumesResponse.Merge(dst, src)
+}
+func (m *VolumesResponse) XXX_Size() int {
+	return xxx_messageInfo_VolumesResponse.Size(m)
+}
+func (m *VolumesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumesResponse proto.InternalMessageInfo
+
+func (m *VolumesResponse) GetVolumes() []*Volume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+func (m *VolumesResponse) GetPagination() *Pagination {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type DeleteNamespaceVolumesRequest struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNamespaceVolumesRequest) Reset()         { *m = DeleteNamespaceVolumesRequest{} }
+func (m *DeleteNamespaceVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNamespaceVolumesRequest) ProtoMessage()    {}
+func (*DeleteNamespaceVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{14}
+}
+func (m *DeleteNamespaceVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNamespaceVolumesRequest.Unmarshal(m, b)
+}
+func (m *DeleteNamespaceVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNamespaceVolumesRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteNamespaceVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNamespaceVolumesRequest.Merge(dst, src)
+}
+func (m *DeleteNamespaceVolumesRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteNamespaceVolumesRequest.Size(m)
+}
+func (m *DeleteNamespaceVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNamespaceVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNamespaceVolumesRequest proto.InternalMessageInfo
+
+func (m *DeleteNamespaceVolumesRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+type Empty struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+func (*Empty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{15}
+}
+func (m *Empty) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Empty.Unmarshal(m, b)
+}
+func (m *Empty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Empty.Marshal(b, m, deterministic)
+}
+func (dst *Empty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Empty.Merge(dst, src)
+}
+func (m *Empty) XXX_Size() int {
+	return xxx_messageInfo_Empty.Size(m)
+}
+func (m *Empty) XXX_DiscardUnknown() {
+	xxx_messageInfo_Empty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Empty proto.InternalMessageInfo
+
+// QuoteVolumeRequest mirrors VolumeQuoteRequest REST model
+type QuoteVolumeRequest struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	// "create" (default) or "resize"
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	TariffId             string   `protobuf:"bytes,3,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	Label                string   `protobuf:"bytes,4,opt,name=label,proto3" json:"label,omitempty"`
+	Storage              string   `protobuf:"bytes,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QuoteVolumeRequest) Reset()         { *m = QuoteVolumeRequest{} }
+func (m *QuoteVolumeRequest) String() string { return proto.CompactTextString(m) }
+func (*QuoteVolumeRequest) ProtoMessage()    {}
+func (*QuoteVolumeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{16}
+}
+func (m *QuoteVolumeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuoteVolumeRequest.Unmarshal(m, b)
+}
+func (m *QuoteVolumeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuoteVolumeRequest.Marshal(b, m, deterministic)
+}
+func (dst *QuoteVolumeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteVolumeRequest.Merge(dst, src)
+}
+func (m *QuoteVolumeRequest) XXX_Size() int {
+	return xxx_messageInfo_QuoteVolumeRequest.Size(m)
+}
+func (m *QuoteVolumeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteVolumeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteVolumeRequest proto.InternalMessageInfo
+
+func (m *QuoteVolumeRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *QuoteVolumeRequest) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *QuoteVolumeRequest) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *QuoteVolumeRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *QuoteVolumeRequest) GetStorage() string {
+	if m != nil {
+		return m.Storage
+	}
+	return ""
+}
+
+// VolumeQuote mirrors VolumeQuote REST model
+type VolumeQuote struct {
+	Action               string   `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Feasible             bool     `protobuf:"varint,2,opt,name=feasible,proto3" json:"feasible,omitempty"`
+	Reasons              []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	Capacity             int64    `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	CurrentCapacity      int64    `protobuf:"varint,5,opt,name=current_capacity,json=currentCapacity,proto3" json:"current_capacity,omitempty"`
+	StorageName          string   `protobuf:"bytes,6,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	TariffId             string   `protobuf:"bytes,7,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	TariffLabel          string   `protobuf:"bytes,8,opt,name=tariff_label,json=tariffLabel,proto3" json:"tariff_label,omitempty"`
+	Price                float64  `protobuf:"fixed64,9,opt,name=price,proto3" json:"price,omitempty"`
+	StorageHeadroom      int64    `protobuf:"varint,10,opt,name=storage_headroom,json=storageHeadroom,proto3" json:"storage_headroom,omitempty"`
+	QuotaLimit           int64    `protobuf:"varint,11,opt,name=quota_limit,json=quotaLimit,proto3" json:"quota_limit,omitempty"`
+	QuotaUsed            int64    `protobuf:"varint,12,opt,name=quota_used,json=quotaUsed,proto3" json:"quota_used,omitempty"`
+	QuotaHeadroom        int64    `protobuf:"varint,13,opt,name=quota_headroom,json=quotaHeadroom,proto3" json:"quota_headroom,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VolumeQuote) Reset()         { *m = VolumeQuote{} }
+func (m *VolumeQuote) String() string { return proto.CompactTextString(m) }
+func (*VolumeQuote) ProtoMessage()    {}
+func (*VolumeQuote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{17}
+}
+func (m *VolumeQuote) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeQuote.Unmarshal(m, b)
+}
+func (m *VolumeQuote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeQuote.Marshal(b, m, deterministic)
+}
+func (dst *VolumeQuote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeQuote.Merge(dst, src)
+}
+func (m *VolumeQuote) XXX_Size() int {
+	return xxx_messageInfo_VolumeQuote.Size(m)
+}
+func (m *VolumeQuote) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeQuote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeQuote proto.InternalMessageInfo
+
+func (m *VolumeQuote) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *VolumeQuote) GetFeasible() bool {
+	if m != nil {
+		return m.Feasible
+	}
+	return false
+}
+
+func (m *VolumeQuote) GetReasons() []string {
+	if m != nil {
+		return m.Reasons
+	}
+	return nil
+}
+
+func (m *VolumeQuote) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *VolumeQuote) GetCurrentCapacity() int64 {
+	if m != nil {
+		return m.CurrentCapacity
+	}
+	return 0
+}
+
+func (m *VolumeQuote) GetStorageName() string {
+	if m != nil {
+		return m.StorageName
+	}
+	return ""
+}
+
+func (m *VolumeQuote) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *VolumeQuote) GetTariffLabel() string {
+	if m != nil {
+		return m.TariffLabel
+	}
+	return ""
+}
+
+func (m *VolumeQuote) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *VolumeQuote) GetStorageHeadroom() int64 {
+	if m != nil {
+		return m.StorageHeadroom
+	}
+	return 0
+}
+
+func (m *VolumeQuote) GetQuotaLimit() int64 {
+	if m != nil {
+		return m.QuotaLimit
+	}
+	return 0
+}
+
+func (m *VolumeQuote) GetQuotaUsed() int64 {
+	if m != nil {
+		return m.QuotaUsed
+	}
+	return 0
+}
+
+func (m *VolumeQuote) GetQuotaHeadroom() int64 {
+	if m != nil {
+		return m.QuotaHeadroom
+	}
+	return 0
+}
+
+type ImportVolume struct {
+	NamespaceId string `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Label       string `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	// Imported volumes are stored in whole GiB
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	StorageName          string   `protobuf:"bytes,4,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	OwnerUserId          string   `protobuf:"bytes,5,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportVolume) Reset()         { *m = ImportVolume{} }
+func (m *ImportVolume) String() string { return proto.CompactTextString(m) }
+func (*ImportVolume) ProtoMessage()    {}
+func (*ImportVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{18}
+}
+func (m *ImportVolume) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportVolume.Unmarshal(m, b)
+}
+func (m *ImportVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportVolume.Marshal(b, m, deterministic)
+}
+func (dst *ImportVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportVolume.Merge(dst, src)
+}
+func (m *ImportVolume) XXX_Size() int {
+	return xxx_messageInfo_ImportVolume.Size(m)
+}
+func (m *ImportVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportVolume proto.InternalMessageInfo
+
+func (m *ImportVolume) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ImportVolume) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ImportVolume) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *ImportVolume) GetStorageName() string {
+	if m != nil {
+		return m.StorageName
+	}
+	return ""
+}
+
+func (m *ImportVolume) GetOwnerUserId() string {
+	if m != nil {
+		return m.OwnerUserId
+	}
+	return ""
+}
+
+type ImportVolumesRequest struct {
+	Volumes              []*ImportVolume `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ImportVolumesRequest) Reset()         { *m = ImportVolumesRequest{} }
+func (m *ImportVolumesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportVolumesRequest) ProtoMessage()    {}
+func (*ImportVolumesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{19}
+}
+func (m *ImportVolumesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportVolumesRequest.Unmarshal(m, b)
+}
+func (m *ImportVolumesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportVolumesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportVolumesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportVolumesRequest.Merge(dst, src)
+}
+func (m *ImportVolumesRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportVolumesRequest.Size(m)
+}
+func (m *ImportVolumesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportVolumesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportVolumesRequest proto.InternalMessageInfo
+
+func (m *ImportVolumesRequest) GetVolumes() []*ImportVolume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type ImportResult struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{20}
+}
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (dst *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(dst, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportResult) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ImportResult) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ImportResponse struct {
+	Imported             []*ImportResult `protobuf:"bytes,1,rep,name=imported,proto3" json:"imported,omitempty"`
+	Failed               []*ImportResult `protobuf:"bytes,2,rep,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{21}
+}
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
+}
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(dst, src)
+}
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
+}
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
+
+func (m *ImportResponse) GetImported() []*ImportResult {
+	if m != nil {
+		return m.Imported
+	}
+	return nil
+}
+
+func (m *ImportResponse) GetFailed() []*ImportResult {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+// KubeVolume is volume as reported by kube backend
+type KubeVolume struct {
+	Name                 string               `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Namespace            string               `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	CreateTime           *timestamp.Timestamp `protobuf:"bytes,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	BoundTime            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=bound_time,json=boundTime,proto3" json:"bound_time,omitempty"`
+	TariffId             string               `protobuf:"bytes,5,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	Capacity             int64                `protobuf:"varint,6,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	StorageName          string               `protobuf:"bytes,7,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	AccessMode           string               `protobuf:"bytes,8,opt,name=access_mode,json=accessMode,proto3" json:"access_mode,omitempty"`
+	Status               string               `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	Reason               string               `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cluster              string               `protobuf:"bytes,11,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *KubeVolume) Reset()         { *m = KubeVolume{} }
+func (m *KubeVolume) String() string { return proto.CompactTextString(m) }
+func (*KubeVolume) ProtoMessage()    {}
+func (*KubeVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{22}
+}
+func (m *KubeVolume) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KubeVolume.Unmarshal(m, b)
+}
+func (m *KubeVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KubeVolume.Marshal(b, m, deterministic)
+}
+func (dst *KubeVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KubeVolume.Merge(dst, src)
+}
+func (m *KubeVolume) XXX_Size() int {
+	return xxx_messageInfo_KubeVolume.Size(m)
+}
+func (m *KubeVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_KubeVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KubeVolume proto.InternalMessageInfo
+
+func (m *KubeVolume) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetCreateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreateTime
+	}
+	return nil
+}
+
+func (m *KubeVolume) GetBoundTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.BoundTime
+	}
+	return nil
+}
+
+func (m *KubeVolume) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *KubeVolume) GetStorageName() string {
+	if m != nil {
+		return m.StorageName
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetAccessMode() string {
+	if m != nil {
+		return m.AccessMode
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *KubeVolume) GetCluster() string {
+	if m != nil {
+		return m.Cluster
+	}
+	return ""
+}
+
+// VolumeViews mirrors VolumeViews REST model
+type VolumeViews struct {
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Not set if volume not exists in database
+	Db *Volume `protobuf:"bytes,2,opt,name=db,proto3" json:"db,omitempty"`
+	// Not set if volume not exists in kube backend or backend request failed
+	Kube                 *KubeVolume `protobuf:"bytes,3,opt,name=kube,proto3" json:"kube,omitempty"`
+	KubeError            string      `protobuf:"bytes,4,opt,name=kube_error,json=kubeError,proto3" json:"kube_error,omitempty"`
+	Mismatches           []string    `protobuf:"bytes,5,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *VolumeViews) Reset()         { *m = VolumeViews{} }
+func (m *VolumeViews) String() string { return proto.CompactTextString(m) }
+func (*VolumeViews) ProtoMessage()    {}
+func (*VolumeViews) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{23}
+}
+func (m *VolumeViews) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumeViews.Unmarshal(m, b)
+}
+func (m *VolumeViews) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumeViews.Marshal(b, m, deterministic)
+}
+func (dst *VolumeViews) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumeViews.Merge(dst, src)
+}
+func (m *VolumeViews) XXX_Size() int {
+	return xxx_messageInfo_VolumeViews.Size(m)
+}
+func (m *VolumeViews) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumeViews.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumeViews proto.InternalMessageInfo
+
+func (m *VolumeViews) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *VolumeViews) GetDb() *Volume {
+	if m != nil {
+		return m.Db
+	}
+	return nil
+}
+
+func (m *VolumeViews) GetKube() *KubeVolume {
+	if m != nil {
+		return m.Kube
+	}
+	return nil
+}
+
+func (m *VolumeViews) GetKubeError() string {
+	if m != nil {
+		return m.KubeError
+	}
+	return ""
+}
+
+func (m *VolumeViews) GetMismatches() []string {
+	if m != nil {
+		return m.Mismatches
+	}
+	return nil
+}
+
+type GetNamespaceVolumeViewsRequest struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNamespaceVolumeViewsRequest) Reset()         { *m = GetNamespaceVolumeViewsRequest{} }
+func (m *GetNamespaceVolumeViewsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNamespaceVolumeViewsRequest) ProtoMessage()    {}
+func (*GetNamespaceVolumeViewsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{24}
+}
+func (m *GetNamespaceVolumeViewsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNamespaceVolumeViewsRequest.Unmarshal(m, b)
+}
+func (m *GetNamespaceVolumeViewsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNamespaceVolumeViewsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetNamespaceVolumeViewsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNamespaceVolumeViewsRequest.Merge(dst, src)
+}
+func (m *GetNamespaceVolumeViewsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNamespaceVolumeViewsRequest.Size(m)
+}
+func (m *GetNamespaceVolumeViewsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNamespaceVolumeViewsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNamespaceVolumeViewsRequest proto.InternalMessageInfo
+
+func (m *GetNamespaceVolumeViewsRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+type NamespaceVolumeViews struct {
+	Volumes              []*VolumeViews `protobuf:"bytes,1,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *NamespaceVolumeViews) Reset()         { *m = NamespaceVolumeViews{} }
+func (m *NamespaceVolumeViews) String() string { return proto.CompactTextString(m) }
+func (*NamespaceVolumeViews) ProtoMessage()    {}
+func (*NamespaceVolumeViews) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{25}
+}
+func (m *NamespaceVolumeViews) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceVolumeViews.Unmarshal(m, b)
+}
+func (m *NamespaceVolumeViews) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceVolumeViews.Marshal(b, m, deterministic)
+}
+func (dst *NamespaceVolumeViews) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceVolumeViews.Merge(dst, src)
+}
+func (m *NamespaceVolumeViews) XXX_Size() int {
+	return xxx_messageInfo_NamespaceVolumeViews.Size(m)
+}
+func (m *NamespaceVolumeViews) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceVolumeViews.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceVolumeViews proto.InternalMessageInfo
+
+func (m *NamespaceVolumeViews) GetVolumes() []*VolumeViews {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type ReconcileBillingRequest struct {
+	Fix                  bool     `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileBillingRequest) Reset()         { *m = ReconcileBillingRequest{} }
+func (m *ReconcileBillingRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileBillingRequest) ProtoMessage()    {}
+func (*ReconcileBillingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{26}
+}
+func (m *ReconcileBillingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileBillingRequest.Unmarshal(m, b)
+}
+func (m *ReconcileBillingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileBillingRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReconcileBillingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileBillingRequest.Merge(dst, src)
+}
+func (m *ReconcileBillingRequest) XXX_Size() int {
+	return xxx_messageInfo_ReconcileBillingRequest.Size(m)
+}
+func (m *ReconcileBillingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileBillingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileBillingRequest proto.InternalMessageInfo
+
+func (m *ReconcileBillingRequest) GetFix() bool {
+	if m != nil {
+		return m.Fix
+	}
+	return false
+}
+
+type BillingSubscription struct {
+	ResourceId           string               `protobuf:"bytes,1,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceLabel        string               `protobuf:"bytes,2,opt,name=resource_label,json=resourceLabel,proto3" json:"resource_label,omitempty"`
+	ResourceType         string               `protobuf:"bytes,3,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	TariffId             string               `protobuf:"bytes,4,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	UserId               string               `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *BillingSubscription) Reset()         { *m = BillingSubscription{} }
+func (m *BillingSubscription) String() string { return proto.CompactTextString(m) }
+func (*BillingSubscription) ProtoMessage()    {}
+func (*BillingSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{27}
+}
+func (m *BillingSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BillingSubscription.Unmarshal(m, b)
+}
+func (m *BillingSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BillingSubscription.Marshal(b, m, deterministic)
+}
+func (dst *BillingSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingSubscription.Merge(dst, src)
+}
+func (m *BillingSubscription) XXX_Size() int {
+	return xxx_messageInfo_BillingSubscription.Size(m)
+}
+func (m *BillingSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingSubscription proto.InternalMessageInfo
+
+func (m *BillingSubscription) GetResourceId() string {
+	if m != nil {
+		return m.ResourceId
+	}
+	return ""
+}
+
+func (m *BillingSubscription) GetResourceLabel() string {
+	if m != nil {
+		return m.ResourceLabel
+	}
+	return ""
+}
+
+func (m *BillingSubscription) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func (m *BillingSubscription) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *BillingSubscription) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *BillingSubscription) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type ReconciliationVolume struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NamespaceId          string   `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	TariffId             string   `protobuf:"bytes,4,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	OwnerUserId          string   `protobuf:"bytes,5,opt,name=owner_user_id,json=ownerUserId,proto3" json:"owner_user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconciliationVolume) Reset()         { *m = ReconciliationVolume{} }
+func (m *ReconciliationVolume) String() string { return proto.CompactTextString(m) }
+func (*ReconciliationVolume) ProtoMessage()    {}
+func (*ReconciliationVolume) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{28}
+}
+func (m *ReconciliationVolume) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconciliationVolume.Unmarshal(m, b)
+}
+func (m *ReconciliationVolume) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconciliationVolume.Marshal(b, m, deterministic)
+}
+func (dst *ReconciliationVolume) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconciliationVolume.Merge(dst, src)
+}
+func (m *ReconciliationVolume) XXX_Size() int {
+	return xxx_messageInfo_ReconciliationVolume.Size(m)
+}
+func (m *ReconciliationVolume) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconciliationVolume.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconciliationVolume proto.InternalMessageInfo
+
+func (m *ReconciliationVolume) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ReconciliationVolume) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ReconciliationVolume) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ReconciliationVolume) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *ReconciliationVolume) GetOwnerUserId() string {
+	if m != nil {
+		return m.OwnerUserId
+	}
+	return ""
+}
+
+type TariffMismatch struct {
+	Volume               *ReconciliationVolume `protobuf:"bytes,1,opt,name=volume,proto3" json:"volume,omitempty"`
+	SubscriptionTariffId string                `protobuf:"bytes,2,opt,name=subscription_tariff_id,json=subscriptionTariffId,proto3" json:"subscription_tariff_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *TariffMismatch) Reset()         { *m = TariffMismatch{} }
+func (m *TariffMismatch) String() string { return proto.CompactTextString(m) }
+func (*TariffMismatch) ProtoMessage()    {}
+func (*TariffMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{29}
+}
+func (m *TariffMismatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TariffMismatch.Unmarshal(m, b)
+}
+func (m *TariffMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TariffMismatch.Marshal(b, m, deterministic)
+}
+func (dst *TariffMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TariffMismatch.Merge(dst, src)
+}
+func (m *TariffMismatch) XXX_Size() int {
+	return xxx_messageInfo_TariffMismatch.Size(m)
+}
+func (m *TariffMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_TariffMismatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TariffMismatch proto.InternalMessageInfo
+
+func (m *TariffMismatch) GetVolume() *ReconciliationVolume {
+	if m != nil {
+		return m.Volume
+	}
+	return nil
+}
+
+func (m *TariffMismatch) GetSubscriptionTariffId() string {
+	if m != nil {
+		return m.SubscriptionTariffId
+	}
+	return ""
+}
+
+// BillingReconciliationReport mirrors BillingReconciliationReport REST model
+type BillingReconciliationReport struct {
+	Fix                         bool                    `protobuf:"varint,1,opt,name=fix,proto3" json:"fix,omitempty"`
+	SubscriptionsWithoutVolumes []*BillingSubscription  `protobuf:"bytes,2,rep,name=subscriptions_without_volumes,json=subscriptionsWithoutVolumes,proto3" json:"subscriptions_without_volumes,omitempty"`
+	VolumesWithoutSubscriptions []*ReconciliationVolume `protobuf:"bytes,3,rep,name=volumes_without_subscriptions,json=volumesWithoutSubscriptions,proto3" json:"volumes_without_subscriptions,omitempty"`
+	TariffMismatches            []*TariffMismatch       `protobuf:"bytes,4,rep,name=tariff_mismatches,json=tariffMismatches,proto3" json:"tariff_mismatches,omitempty"`
+	Failed                      []*ImportResult         `protobuf:"bytes,5,rep,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral        struct{}                `json:"-"`
+	XXX_unrecognized            []byte                  `json:"-"`
+	XXX_sizecache               int32                   `json:"-"`
+}
+
+func (m *BillingReconciliationReport) Reset()         { *m = BillingReconciliationReport{} }
+func (m *BillingReconciliationReport) String() string { return proto.CompactTextString(m) }
+func (*BillingReconciliationReport) ProtoMessage()    {}
+func (*BillingReconciliationReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{30}
+}
+func (m *BillingReconciliationReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BillingReconciliationReport.Unmarshal(m, b)
+}
+func (m *BillingReconciliationReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BillingReconciliationReport.Marshal(b, m, deterministic)
+}
+func (dst *BillingReconciliationReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BillingReconciliationReport.Merge(dst, src)
+}
+func (m *BillingReconciliationReport) XXX_Size() int {
+	return xxx_messageInfo_BillingReconciliationReport.Size(m)
+}
+func (m *BillingReconciliationReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_BillingReconciliationReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BillingReconciliationReport proto.InternalMessageInfo
+
+func (m *BillingReconciliationReport) GetFix() bool {
+	if m != nil {
+		return m.Fix
+	}
+	return false
+}
+
+func (m *BillingReconciliationReport) GetSubscriptionsWithoutVolumes() []*BillingSubscription {
+	if m != nil {
+		return m.SubscriptionsWithoutVolumes
+	}
+	return nil
+}
+
+func (m *BillingReconciliationReport) GetVolumesWithoutSubscriptions() []*ReconciliationVolume {
+	if m != nil {
+		return m.VolumesWithoutSubscriptions
+	}
+	return nil
+}
+
+func (m *BillingReconciliationReport) GetTariffMismatches() []*TariffMismatch {
+	if m != nil {
+		return m.TariffMismatches
+	}
+	return nil
+}
+
+func (m *BillingReconciliationReport) GetFailed() []*ImportResult {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+// MigrateTariffRequest mirrors TariffMigrationRequest REST model
+type MigrateTariffRequest struct {
+	FromTariffId         string   `protobuf:"bytes,1,opt,name=from_tariff_id,json=fromTariffId,proto3" json:"from_tariff_id,omitempty"`
+	ToTariffId           string   `protobuf:"bytes,2,opt,name=to_tariff_id,json=toTariffId,proto3" json:"to_tariff_id,omitempty"`
+	BatchSize            int32    `protobuf:"varint,3,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	DryRun               bool     `protobuf:"varint,4,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateTariffRequest) Reset()         { *m = MigrateTariffRequest{} }
+func (m *MigrateTariffRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateTariffRequest) ProtoMessage()    {}
+func (*MigrateTariffRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{31}
+}
+func (m *MigrateTariffRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateTariffRequest.Unmarshal(m, b)
+}
+func (m *MigrateTariffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateTariffRequest.Marshal(b, m, deterministic)
+}
+func (dst *MigrateTariffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateTariffRequest.Merge(dst, src)
+}
+func (m *MigrateTariffRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateTariffRequest.Size(m)
+}
+func (m *MigrateTariffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateTariffRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateTariffRequest proto.InternalMessageInfo
+
+func (m *MigrateTariffRequest) GetFromTariffId() string {
+	if m != nil {
+		return m.FromTariffId
+	}
+	return ""
+}
+
+func (m *MigrateTariffRequest) GetToTariffId() string {
+	if m != nil {
+		return m.ToTariffId
+	}
+	return ""
+}
+
+func (m *MigrateTariffRequest) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
+}
+
+func (m *MigrateTariffRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type TariffMigrationResult struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NamespaceId          string   `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	OldCapacity          int64    `protobuf:"varint,4,opt,name=old_capacity,json=oldCapacity,proto3" json:"old_capacity,omitempty"`
+	NewCapacity          int64    `protobuf:"varint,5,opt,name=new_capacity,json=newCapacity,proto3" json:"new_capacity,omitempty"`
+	Migrated             bool     `protobuf:"varint,6,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TariffMigrationResult) Reset()         { *m = TariffMigrationResult{} }
+func (m *TariffMigrationResult) String() string { return proto.CompactTextString(m) }
+func (*TariffMigrationResult) ProtoMessage()    {}
+func (*TariffMigrationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{32}
+}
+func (m *TariffMigrationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TariffMigrationResult.Unmarshal(m, b)
+}
+func (m *TariffMigrationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TariffMigrationResult.Marshal(b, m, deterministic)
+}
+func (dst *TariffMigrationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TariffMigrationResult.Merge(dst, src)
+}
+func (m *TariffMigrationResult) XXX_Size() int {
+	return xxx_messageInfo_TariffMigrationResult.Size(m)
+}
+func (m *TariffMigrationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TariffMigrationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TariffMigrationResult proto.InternalMessageInfo
+
+func (m *TariffMigrationResult) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *TariffMigrationResult) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *TariffMigrationResult) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *TariffMigrationResult) GetOldCapacity() int64 {
+	if m != nil {
+		return m.OldCapacity
+	}
+	return 0
+}
+
+func (m *TariffMigrationResult) GetNewCapacity() int64 {
+	if m != nil {
+		return m.NewCapacity
+	}
+	return 0
+}
+
+func (m *TariffMigrationResult) GetMigrated() bool {
+	if m != nil {
+		return m.Migrated
+	}
+	return false
+}
+
+func (m *TariffMigrationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type TariffMigrationReport struct {
+	FromTariffId         string                   `protobuf:"bytes,1,opt,name=from_tariff_id,json=fromTariffId,proto3" json:"from_tariff_id,omitempty"`
+	ToTariffId           string                   `protobuf:"bytes,2,opt,name=to_tariff_id,json=toTariffId,proto3" json:"to_tariff_id,omitempty"`
+	DryRun               bool                     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Batches              int32                    `protobuf:"varint,4,opt,name=batches,proto3" json:"batches,omitempty"`
+	Migrated             int32                    `protobuf:"varint,5,opt,name=migrated,proto3" json:"migrated,omitempty"`
+	Failed               int32                    `protobuf:"varint,6,opt,name=failed,proto3" json:"failed,omitempty"`
+	Results              []*TariffMigrationResult `protobuf:"bytes,7,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *TariffMigrationReport) Reset()         { *m = TariffMigrationReport{} }
+func (m *TariffMigrationReport) String() string { return proto.CompactTextString(m) }
+func (*TariffMigrationReport) ProtoMessage()    {}
+func (*TariffMigrationReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{33}
+}
+func (m *TariffMigrationReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TariffMigrationReport.Unmarshal(m, b)
+}
+func (m *TariffMigrationReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TariffMigrationReport.Marshal(b, m, deterministic)
+}
+func (dst *TariffMigrationReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TariffMigrationReport.Merge(dst, src)
+}
+func (m *TariffMigrationReport) XXX_Size() int {
+	return xxx_messageInfo_TariffMigrationReport.Size(m)
+}
+func (m *TariffMigrationReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_TariffMigrationReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TariffMigrationReport proto.InternalMessageInfo
+
+func (m *TariffMigrationReport) GetFromTariffId() string {
+	if m != nil {
+		return m.FromTariffId
+	}
+	return ""
+}
+
+func (m *TariffMigrationReport) GetToTariffId() string {
+	if m != nil {
+		return m.ToTariffId
+	}
+	return ""
+}
+
+func (m *TariffMigrationReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *TariffMigrationReport) GetBatches() int32 {
+	if m != nil {
+		return m.Batches
+	}
+	return 0
+}
+
+func (m *TariffMigrationReport) GetMigrated() int32 {
+	if m != nil {
+		return m.Migrated
+	}
+	return 0
+}
+
+func (m *TariffMigrationReport) GetFailed() int32 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *TariffMigrationReport) GetResults() []*TariffMigrationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// GetMeteringRequest has the same defaults as REST: now for range end, 30 days before end for range start
+type GetMeteringRequest struct {
+	From                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetMeteringRequest) Reset()         { *m = GetMeteringRequest{} }
+func (m *GetMeteringRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMeteringRequest) ProtoMessage()    {}
+func (*GetMeteringRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{34}
+}
+func (m *GetMeteringRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMeteringRequest.Unmarshal(m, b)
+}
+func (m *GetMeteringRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMeteringRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetMeteringRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMeteringRequest.Merge(dst, src)
+}
+func (m *GetMeteringRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMeteringRequest.Size(m)
+}
+func (m *GetMeteringRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMeteringRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMeteringRequest proto.InternalMessageInfo
+
+func (m *GetMeteringRequest) GetFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *GetMeteringRequest) GetTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+type MeteringRecord struct {
+	VolumeId             string   `protobuf:"bytes,1,opt,name=volume_id,json=volumeId,proto3" json:"volume_id,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	NamespaceId          string   `protobuf:"bytes,3,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	StorageName          string   `protobuf:"bytes,4,opt,name=storage_name,json=storageName,proto3" json:"storage_name,omitempty"`
+	TariffId             string   `protobuf:"bytes,5,opt,name=tariff_id,json=tariffId,proto3" json:"tariff_id,omitempty"`
+	GibHours             float64  `protobuf:"fixed64,6,opt,name=gib_hours,json=gibHours,proto3" json:"gib_hours,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeteringRecord) Reset()         { *m = MeteringRecord{} }
+func (m *MeteringRecord) String() string { return proto.CompactTextString(m) }
+func (*MeteringRecord) ProtoMessage()    {}
+func (*MeteringRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{35}
+}
+func (m *MeteringRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteringRecord.Unmarshal(m, b)
+}
+func (m *MeteringRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteringRecord.Marshal(b, m, deterministic)
+}
+func (dst *MeteringRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteringRecord.Merge(dst, src)
+}
+func (m *MeteringRecord) XXX_Size() int {
+	return xxx_messageInfo_MeteringRecord.Size(m)
+}
+func (m *MeteringRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteringRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteringRecord proto.InternalMessageInfo
+
+func (m *MeteringRecord) GetVolumeId() string {
+	if m != nil {
+		return m.VolumeId
+	}
+	return ""
+}
+
+func (m *MeteringRecord) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *MeteringRecord) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *MeteringRecord) GetStorageName() string {
+	if m != nil {
+		return m.StorageName
+	}
+	return ""
+}
+
+func (m *MeteringRecord) GetTariffId() string {
+	if m != nil {
+		return m.TariffId
+	}
+	return ""
+}
+
+func (m *MeteringRecord) GetGibHours() float64 {
+	if m != nil {
+		return m.GibHours
+	}
+	return 0
+}
+
+type MeteringReport struct {
+	From                 *timestamp.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   *timestamp.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Records              []*MeteringRecord    `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	ByTariff             map[string]float64   `protobuf:"bytes,4,rep,name=by_tariff,json=byTariff,proto3" json:"by_tariff,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	ByStorage            map[string]float64   `protobuf:"bytes,5,rep,name=by_storage,json=byStorage,proto3" json:"by_storage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	ByNamespace          map[string]float64   `protobuf:"bytes,6,rep,name=by_namespace,json=byNamespace,proto3" json:"by_namespace,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Total                float64              `protobuf:"fixed64,7,opt,name=total,proto3" json:"total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *MeteringReport) Reset()         { *m = MeteringReport{} }
+func (m *MeteringReport) String() string { return proto.CompactTextString(m) }
+func (*MeteringReport) ProtoMessage()    {}
+func (*MeteringReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{36}
+}
+func (m *MeteringReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteringReport.Unmarshal(m, b)
+}
+func (m *MeteringReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteringReport.Marshal(b, m, deterministic)
+}
+func (dst *MeteringReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteringReport.Merge(dst, src)
+}
+func (m *MeteringReport) XXX_Size() int {
+	return xxx_messageInfo_MeteringReport.Size(m)
+}
+func (m *MeteringReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteringReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteringReport proto.InternalMessageInfo
+
+func (m *MeteringReport) GetFrom() *timestamp.Timestamp {
+	if m != nil {
+		return m.From
+	}
+	return nil
+}
+
+func (m *MeteringReport) GetTo() *timestamp.Timestamp {
+	if m != nil {
+		return m.To
+	}
+	return nil
+}
+
+func (m *MeteringReport) GetRecords() []*MeteringRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+func (m *MeteringReport) GetByTariff() map[string]float64 {
+	if m != nil {
+		return m.ByTariff
+	}
+	return nil
+}
+
+func (m *MeteringReport) GetByStorage() map[string]float64 {
+	if m != nil {
+		return m.ByStorage
+	}
+	return nil
+}
+
+func (m *MeteringReport) GetByNamespace() map[string]float64 {
+	if m != nil {
+		return m.ByNamespace
+	}
+	return nil
+}
+
+func (m *MeteringReport) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+type StorageAccess struct {
+	AllowedNamespaces    []string `protobuf:"bytes,1,rep,name=allowed_namespaces,json=allowedNamespaces,proto3" json:"allowed_namespaces,omitempty"`
+	DeniedNamespaces     []string `protobuf:"bytes,2,rep,name=denied_namespaces,json=deniedNamespaces,proto3" json:"denied_namespaces,omitempty"`
+	AllowedUsers         []string `protobuf:"bytes,3,rep,name=allowed_users,json=allowedUsers,proto3" json:"allowed_users,omitempty"`
+	DeniedUsers          []string `protobuf:"bytes,4,rep,name=denied_users,json=deniedUsers,proto3" json:"denied_users,omitempty"`
+	AllowedRoles         []string `protobuf:"bytes,5,rep,name=allowed_roles,json=allowedRoles,proto3" json:"allowed_roles,omitempty"`
+	DeniedRoles          []string `protobuf:"bytes,6,rep,name=denied_roles,json=deniedRoles,proto3" json:"denied_roles,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageAccess) Reset()         { *m = StorageAccess{} }
+func (m *StorageAccess) String() string { return proto.CompactTextString(m) }
+func (*StorageAccess) ProtoMessage()    {}
+func (*StorageAccess) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{37}
+}
+func (m *StorageAccess) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageAccess.Unmarshal(m, b)
+}
+func (m *StorageAccess) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageAccess.Marshal(b, m, deterministic)
+}
+func (dst *StorageAccess) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageAccess.Merge(dst, src)
+}
+func (m *StorageAccess) XXX_Size() int {
+	return xxx_messageInfo_StorageAccess.Size(m)
+}
+func (m *StorageAccess) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageAccess.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageAccess proto.InternalMessageInfo
+
+func (m *StorageAccess) GetAllowedNamespaces() []string {
+	if m != nil {
+		return m.AllowedNamespaces
+	}
+	return nil
+}
+
+func (m *StorageAccess) GetDeniedNamespaces() []string {
+	if m != nil {
+		return m.DeniedNamespaces
+	}
+	return nil
+}
+
+func (m *StorageAccess) GetAllowedUsers() []string {
+	if m != nil {
+		return m.AllowedUsers
+	}
+	return nil
+}
+
+func (m *StorageAccess) GetDeniedUsers() []string {
+	if m != nil {
+		return m.DeniedUsers
+	}
+	return nil
+}
+
+func (m *StorageAccess) GetAllowedRoles() []string {
+	if m != nil {
+		return m.AllowedRoles
+	}
+	return nil
+}
+
+func (m *StorageAccess) GetDeniedRoles() []string {
+	if m != nil {
+		return m.DeniedRoles
+	}
+	return nil
+}
+
+type Storage struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 int64             `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Used                 int64             `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Attributes           map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Access               *StorageAccess    `protobuf:"bytes,5,opt,name=access,proto3" json:"access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Storage) Reset()         { *m = Storage{} }
+func (m *Storage) String() string { return proto.CompactTextString(m) }
+func (*Storage) ProtoMessage()    {}
+func (*Storage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{38}
+}
+func (m *Storage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Storage.Unmarshal(m, b)
+}
+func (m *Storage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Storage.Marshal(b, m, deterministic)
+}
+func (dst *Storage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Storage.Merge(dst, src)
+}
+func (m *Storage) XXX_Size() int {
+	return xxx_messageInfo_Storage.Size(m)
+}
+func (m *Storage) XXX_DiscardUnknown() {
+	xxx_messageInfo_Storage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Storage proto.InternalMessageInfo
+
+func (m *Storage) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Storage) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *Storage) GetUsed() int64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *Storage) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *Storage) GetAccess() *StorageAccess {
+	if m != nil {
+		return m.Access
+	}
+	return nil
+}
+
+type StorageRef struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageRef) Reset()         { *m = StorageRef{} }
+func (m *StorageRef) String() string { return proto.CompactTextString(m) }
+func (*StorageRef) ProtoMessage()    {}
+func (*StorageRef) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{39}
+}
+func (m *StorageRef) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageRef.Unmarshal(m, b)
+}
+func (m *StorageRef) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageRef.Marshal(b, m, deterministic)
+}
+func (dst *StorageRef) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageRef.Merge(dst, src)
+}
+func (m *StorageRef) XXX_Size() int {
+	return xxx_messageInfo_StorageRef.Size(m)
+}
+func (m *StorageRef) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageRef.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageRef proto.InternalMessageInfo
+
+func (m *StorageRef) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListStoragesRequest struct {
+	Page                 *PageRequest `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListStoragesRequest) Reset()         { *m = ListStoragesRequest{} }
+func (m *ListStoragesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStoragesRequest) ProtoMessage()    {}
+func (*ListStoragesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{40}
+}
+func (m *ListStoragesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStoragesRequest.Unmarshal(m, b)
+}
+func (m *ListStoragesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStoragesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListStoragesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStoragesRequest.Merge(dst, src)
+}
+func (m *ListStoragesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListStoragesRequest.Size(m)
+}
+func (m *ListStoragesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStoragesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStoragesRequest proto.InternalMessageInfo
+
+func (m *ListStoragesRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type ListNamespaceStoragesRequest struct {
+	NamespaceId          string       `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Page                 *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListNamespaceStoragesRequest) Reset()         { *m = ListNamespaceStoragesRequest{} }
+func (m *ListNamespaceStoragesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNamespaceStoragesRequest) ProtoMessage()    {}
+func (*ListNamespaceStoragesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{41}
+}
+func (m *ListNamespaceStoragesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNamespaceStoragesRequest.Unmarshal(m, b)
+}
+func (m *ListNamespaceStoragesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNamespaceStoragesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListNamespaceStoragesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamespaceStoragesRequest.Merge(dst, src)
+}
+func (m *ListNamespaceStoragesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNamespaceStoragesRequest.Size(m)
+}
+func (m *ListNamespaceStoragesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamespaceStoragesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamespaceStoragesRequest proto.InternalMessageInfo
+
+func (m *ListNamespaceStoragesRequest) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *ListNamespaceStoragesRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type StoragesResponse struct {
+	Storages             []*Storage  `protobuf:"bytes,1,rep,name=storages,proto3" json:"storages,omitempty"`
+	Pagination           *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *StoragesResponse) Reset()         { *m = StoragesResponse{} }
+func (m *StoragesResponse) String() string { return proto.CompactTextString(m) }
+func (*StoragesResponse) ProtoMessage()    {}
+func (*StoragesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{42}
+}
+func (m *StoragesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StoragesResponse.Unmarshal(m, b)
+}
+func (m *StoragesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StoragesResponse.Marshal(b, m, deterministic)
+}
+func (dst *StoragesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StoragesResponse.Merge(dst, src)
+}
+func (m *StoragesResponse) XXX_Size() int {
+	return xxx_messageInfo_StoragesResponse.Size(m)
+}
+func (m *StoragesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StoragesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StoragesResponse proto.InternalMessageInfo
+
+func (m *StoragesResponse) GetStorages() []*Storage {
+	if m != nil {
+		return m.Storages
+	}
+	return nil
+}
+
+func (m *StoragesResponse) GetPagination() *Pagination {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type GetStorageRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Page of storage volumes
+	Page                 *PageRequest `protobuf:"bytes,2,opt,name=page,proto3" json:"page,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GetStorageRequest) Reset()         { *m = GetStorageRequest{} }
+func (m *GetStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorageRequest) ProtoMessage()    {}
+func (*GetStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{43}
+}
+func (m *GetStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageRequest.Unmarshal(m, b)
+}
+func (m *GetStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageRequest.Merge(dst, src)
+}
+func (m *GetStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStorageRequest.Size(m)
+}
+func (m *GetStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageRequest proto.InternalMessageInfo
+
+func (m *GetStorageRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetStorageRequest) GetPage() *PageRequest {
+	if m != nil {
+		return m.Page
+	}
+	return nil
+}
+
+type NamespaceStorageUsage struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Volumes              int32    `protobuf:"varint,2,opt,name=volumes,proto3" json:"volumes,omitempty"`
+	Capacity             int64    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceStorageUsage) Reset()         { *m = NamespaceStorageUsage{} }
+func (m *NamespaceStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*NamespaceStorageUsage) ProtoMessage()    {}
+func (*NamespaceStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{44}
+}
+func (m *NamespaceStorageUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceStorageUsage.Unmarshal(m, b)
+}
+func (m *NamespaceStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceStorageUsage.Marshal(b, m, deterministic)
+}
+func (dst *NamespaceStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceStorageUsage.Merge(dst, src)
+}
+func (m *NamespaceStorageUsage) XXX_Size() int {
+	return xxx_messageInfo_NamespaceStorageUsage.Size(m)
+}
+func (m *NamespaceStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceStorageUsage proto.InternalMessageInfo
+
+func (m *NamespaceStorageUsage) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *NamespaceStorageUsage) GetVolumes() int32 {
+	if m != nil {
+		return m.Volumes
+	}
+	return 0
+}
+
+func (m *NamespaceStorageUsage) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+type StorageDetails struct {
+	Storage              *Storage                 `protobuf:"bytes,1,opt,name=storage,proto3" json:"storage,omitempty"`
+	Volumes              []*Volume                `protobuf:"bytes,2,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	VolumesPagination    *Pagination              `protobuf:"bytes,3,opt,name=volumes_pagination,json=volumesPagination,proto3" json:"volumes_pagination,omitempty"`
+	VolumesTotal         int32                    `protobuf:"varint,4,opt,name=volumes_total,json=volumesTotal,proto3" json:"volumes_total,omitempty"`
+	Namespaces           []*NamespaceStorageUsage `protobuf:"bytes,5,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *StorageDetails) Reset()         { *m = StorageDetails{} }
+func (m *StorageDetails) String() string { return proto.CompactTextString(m) }
+func (*StorageDetails) ProtoMessage()    {}
+func (*StorageDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{45}
+}
+func (m *StorageDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageDetails.Unmarshal(m, b)
+}
+func (m *StorageDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageDetails.Marshal(b, m, deterministic)
+}
+func (dst *StorageDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageDetails.Merge(dst, src)
+}
+func (m *StorageDetails) XXX_Size() int {
+	return xxx_messageInfo_StorageDetails.Size(m)
+}
+func (m *StorageDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageDetails.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageDetails proto.InternalMessageInfo
+
+func (m *StorageDetails) GetStorage() *Storage {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
+func (m *StorageDetails) GetVolumes() []*Volume {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+func (m *StorageDetails) GetVolumesPagination() *Pagination {
+	if m != nil {
+		return m.VolumesPagination
+	}
+	return nil
+}
+
+func (m *StorageDetails) GetVolumesTotal() int32 {
+	if m != nil {
+		return m.VolumesTotal
+	}
+	return 0
+}
+
+func (m *StorageDetails) GetNamespaces() []*NamespaceStorageUsage {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+type UpdateStorageRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Fields not set are not changed
+	NewName              *wrappers.StringValue `protobuf:"bytes,2,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	Size                 *wrappers.Int64Value  `protobuf:"bytes,3,opt,name=size,proto3" json:"size,omitempty"`
+	Used                 *wrappers.Int64Value  `protobuf:"bytes,4,opt,name=used,proto3" json:"used,omitempty"`
+	Attributes           map[string]string     `protobuf:"bytes,5,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Access               *StorageAccess        `protobuf:"bytes,6,opt,name=access,proto3" json:"access,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateStorageRequest) Reset()         { *m = UpdateStorageRequest{} }
+func (m *UpdateStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateStorageRequest) ProtoMessage()    {}
+func (*UpdateStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{46}
+}
+func (m *UpdateStorageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateStorageRequest.Unmarshal(m, b)
+}
+func (m *UpdateStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateStorageRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateStorageRequest.Merge(dst, src)
+}
+func (m *UpdateStorageRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateStorageRequest.Size(m)
+}
+func (m *UpdateStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateStorageRequest proto.InternalMessageInfo
+
+func (m *UpdateStorageRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *UpdateStorageRequest) GetNewName() *wrappers.StringValue {
+	if m != nil {
+		return m.NewName
+	}
+	return nil
+}
+
+func (m *UpdateStorageRequest) GetSize() *wrappers.Int64Value {
+	if m != nil {
+		return m.Size
+	}
+	return nil
+}
+
+func (m *UpdateStorageRequest) GetUsed() *wrappers.Int64Value {
+	if m != nil {
+		return m.Used
+	}
+	return nil
+}
+
+func (m *UpdateStorageRequest) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+func (m *UpdateStorageRequest) GetAccess() *StorageAccess {
+	if m != nil {
+		return m.Access
+	}
+	return nil
+}
+
+type StorageImport struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Default import storage size is used if not set
+	Size                 int64                `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Used                 *wrappers.Int64Value `protobuf:"bytes,3,opt,name=used,proto3" json:"used,omitempty"`
+	Attributes           map[string]string    `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StorageImport) Reset()         { *m = StorageImport{} }
+func (m *StorageImport) String() string { return proto.CompactTextString(m) }
+func (*StorageImport) ProtoMessage()    {}
+func (*StorageImport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{47}
+}
+func (m *StorageImport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageImport.Unmarshal(m, b)
+}
+func (m *StorageImport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageImport.Marshal(b, m, deterministic)
+}
+func (dst *StorageImport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageImport.Merge(dst, src)
+}
+func (m *StorageImport) XXX_Size() int {
+	return xxx_messageInfo_StorageImport.Size(m)
+}
+func (m *StorageImport) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageImport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageImport proto.InternalMessageInfo
+
+func (m *StorageImport) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorageImport) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StorageImport) GetUsed() *wrappers.Int64Value {
+	if m != nil {
+		return m.Used
+	}
+	return nil
+}
+
+func (m *StorageImport) GetAttributes() map[string]string {
+	if m != nil {
+		return m.Attributes
+	}
+	return nil
+}
+
+type ImportStoragesRequest struct {
+	Storages             []*StorageImport `protobuf:"bytes,1,rep,name=storages,proto3" json:"storages,omitempty"`
+	DryRun               bool             `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportStoragesRequest) Reset()         { *m = ImportStoragesRequest{} }
+func (m *ImportStoragesRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportStoragesRequest) ProtoMessage()    {}
+func (*ImportStoragesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{48}
+}
+func (m *ImportStoragesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportStoragesRequest.Unmarshal(m, b)
+}
+func (m *ImportStoragesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportStoragesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportStoragesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportStoragesRequest.Merge(dst, src)
+}
+func (m *ImportStoragesRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportStoragesRequest.Size(m)
+}
+func (m *ImportStoragesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportStoragesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportStoragesRequest proto.InternalMessageInfo
+
+func (m *ImportStoragesRequest) GetStorages() []*StorageImport {
+	if m != nil {
+		return m.Storages
+	}
+	return nil
+}
+
+func (m *ImportStoragesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type RecalculateStoragesUsageRequest struct {
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RecalculateStoragesUsageRequest) Reset()         { *m = RecalculateStoragesUsageRequest{} }
+func (m *RecalculateStoragesUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*RecalculateStoragesUsageRequest) ProtoMessage()    {}
+func (*RecalculateStoragesUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{49}
+}
+func (m *RecalculateStoragesUsageRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RecalculateStoragesUsageRequest.Unmarshal(m, b)
+}
+func (m *RecalculateStoragesUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RecalculateStoragesUsageRequest.Marshal(b, m, deterministic)
+}
+func (dst *RecalculateStoragesUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecalculateStoragesUsageRequest.Merge(dst, src)
+}
+func (m *RecalculateStoragesUsageRequest) XXX_Size() int {
+	return xxx_messageInfo_RecalculateStoragesUsageRequest.Size(m)
+}
+func (m *RecalculateStoragesUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecalculateStoragesUsageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecalculateStoragesUsageRequest proto.InternalMessageInfo
+
+func (m *RecalculateStoragesUsageRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type StorageUsageCheck struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Recorded             int64    `protobuf:"varint,2,opt,name=recorded,proto3" json:"recorded,omitempty"`
+	Actual               int64    `protobuf:"varint,3,opt,name=actual,proto3" json:"actual,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StorageUsageCheck) Reset()         { *m = StorageUsageCheck{} }
+func (m *StorageUsageCheck) String() string { return proto.CompactTextString(m) }
+func (*StorageUsageCheck) ProtoMessage()    {}
+func (*StorageUsageCheck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{50}
+}
+func (m *StorageUsageCheck) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageUsageCheck.Unmarshal(m, b)
+}
+func (m *StorageUsageCheck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageUsageCheck.Marshal(b, m, deterministic)
+}
+func (dst *StorageUsageCheck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsageCheck.Merge(dst, src)
+}
+func (m *StorageUsageCheck) XXX_Size() int {
+	return xxx_messageInfo_StorageUsageCheck.Size(m)
+}
+func (m *StorageUsageCheck) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsageCheck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsageCheck proto.InternalMessageInfo
+
+func (m *StorageUsageCheck) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorageUsageCheck) GetRecorded() int64 {
+	if m != nil {
+		return m.Recorded
+	}
+	return 0
+}
+
+func (m *StorageUsageCheck) GetActual() int64 {
+	if m != nil {
+		return m.Actual
+	}
+	return 0
+}
+
+type StorageUsageReport struct {
+	DryRun               bool                 `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Discrepancies        []*StorageUsageCheck `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *StorageUsageReport) Reset()         { *m = StorageUsageReport{} }
+func (m *StorageUsageReport) String() string { return proto.CompactTextString(m) }
+func (*StorageUsageReport) ProtoMessage()    {}
+func (*StorageUsageReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{51}
+}
+func (m *StorageUsageReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageUsageReport.Unmarshal(m, b)
+}
+func (m *StorageUsageReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageUsageReport.Marshal(b, m, deterministic)
+}
+func (dst *StorageUsageReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageUsageReport.Merge(dst, src)
+}
+func (m *StorageUsageReport) XXX_Size() int {
+	return xxx_messageInfo_StorageUsageReport.Size(m)
+}
+func (m *StorageUsageReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageUsageReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageUsageReport proto.InternalMessageInfo
+
+func (m *StorageUsageReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *StorageUsageReport) GetDiscrepancies() []*StorageUsageCheck {
+	if m != nil {
+		return m.Discrepancies
+	}
+	return nil
+}
+
+type SyncStoragesRequest struct {
+	DryRun               bool     `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncStoragesRequest) Reset()         { *m = SyncStoragesRequest{} }
+func (m *SyncStoragesRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncStoragesRequest) ProtoMessage()    {}
+func (*SyncStoragesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{52}
+}
+func (m *SyncStoragesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncStoragesRequest.Unmarshal(m, b)
+}
+func (m *SyncStoragesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncStoragesRequest.Marshal(b, m, deterministic)
+}
+func (dst *SyncStoragesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncStoragesRequest.Merge(dst, src)
+}
+func (m *SyncStoragesRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncStoragesRequest.Size(m)
+}
+func (m *SyncStoragesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncStoragesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncStoragesRequest proto.InternalMessageInfo
+
+func (m *SyncStoragesRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type StorageSyncReport struct {
+	DryRun               bool            `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Created              []string        `protobuf:"bytes,2,rep,name=created,proto3" json:"created,omitempty"`
+	Updated              []string        `protobuf:"bytes,3,rep,name=updated,proto3" json:"updated,omitempty"`
+	Deleted              []string        `protobuf:"bytes,4,rep,name=deleted,proto3" json:"deleted,omitempty"`
+	Failed               []*ImportResult `protobuf:"bytes,5,rep,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *StorageSyncReport) Reset()         { *m = StorageSyncReport{} }
+func (m *StorageSyncReport) String() string { return proto.CompactTextString(m) }
+func (*StorageSyncReport) ProtoMessage()    {}
+func (*StorageSyncReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{53}
+}
+func (m *StorageSyncReport) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageSyncReport.Unmarshal(m, b)
+}
+func (m *StorageSyncReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageSyncReport.Marshal(b, m, deterministic)
+}
+func (dst *StorageSyncReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageSyncReport.Merge(dst, src)
+}
+func (m *StorageSyncReport) XXX_Size() int {
+	return xxx_messageInfo_StorageSyncReport.Size(m)
+}
+func (m *StorageSyncReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageSyncReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageSyncReport proto.InternalMessageInfo
+
+func (m *StorageSyncReport) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *StorageSyncReport) GetCreated() []string {
+	if m != nil {
+		return m.Created
+	}
+	return nil
+}
+
+func (m *StorageSyncReport) GetUpdated() []string {
+	if m != nil {
+		return m.Updated
+	}
+	return nil
+}
+
+func (m *StorageSyncReport) GetDeleted() []string {
+	if m != nil {
+		return m.Deleted
+	}
+	return nil
+}
+
+func (m *StorageSyncReport) GetFailed() []*ImportResult {
+	if m != nil {
+		return m.Failed
+	}
+	return nil
+}
+
+type GetStorageForecastRequest struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Days of history used for projection, server default if not set
+	Days                 int32    `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStorageForecastRequest) Reset()         { *m = GetStorageForecastRequest{} }
+func (m *GetStorageForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStorageForecastRequest) ProtoMessage()    {}
+func (*GetStorageForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{54}
+}
+func (m *GetStorageForecastRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStorageForecastRequest.Unmarshal(m, b)
+}
+func (m *GetStorageForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStorageForecastRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetStorageForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStorageForecastRequest.Merge(dst, src)
+}
+func (m *GetStorageForecastRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStorageForecastRequest.Size(m)
+}
+func (m *GetStorageForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStorageForecastRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStorageForecastRequest proto.InternalMessageInfo
+
+func (m *GetStorageForecastRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GetStorageForecastRequest) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+type NamespaceUsageGrowth struct {
+	NamespaceId          string   `protobuf:"bytes,1,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	Capacity             int64    `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	GrowthPerDay         int64    `protobuf:"varint,3,opt,name=growth_per_day,json=growthPerDay,proto3" json:"growth_per_day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceUsageGrowth) Reset()         { *m = NamespaceUsageGrowth{} }
+func (m *NamespaceUsageGrowth) String() string { return proto.CompactTextString(m) }
+func (*NamespaceUsageGrowth) ProtoMessage()    {}
+func (*NamespaceUsageGrowth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{55}
+}
+func (m *NamespaceUsageGrowth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceUsageGrowth.Unmarshal(m, b)
+}
+func (m *NamespaceUsageGrowth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceUsageGrowth.Marshal(b, m, deterministic)
+}
+func (dst *NamespaceUsageGrowth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceUsageGrowth.Merge(dst, src)
+}
+func (m *NamespaceUsageGrowth) XXX_Size() int {
+	return xxx_messageInfo_NamespaceUsageGrowth.Size(m)
+}
+func (m *NamespaceUsageGrowth) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceUsageGrowth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceUsageGrowth proto.InternalMessageInfo
+
+func (m *NamespaceUsageGrowth) GetNamespaceId() string {
+	if m != nil {
+		return m.NamespaceId
+	}
+	return ""
+}
+
+func (m *NamespaceUsageGrowth) GetCapacity() int64 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *NamespaceUsageGrowth) GetGrowthPerDay() int64 {
+	if m != nil {
+		return m.GrowthPerDay
+	}
+	return 0
+}
+
+// StorageForecast mirrors StorageForecast REST model
+type StorageForecast struct {
+	Name                 string                  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Size                 int64                   `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Used                 int64                   `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Samples              int32                   `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
+	GrowthPerDay         int64                   `protobuf:"varint,5,opt,name=growth_per_day,json=growthPerDay,proto3" json:"growth_per_day,omitempty"`
+	DaysToFull           *wrappers.DoubleValue   `protobuf:"bytes,6,opt,name=days_to_full,json=daysToFull,proto3" json:"days_to_full,omitempty"`
+	FullDate             *timestamp.Timestamp    `protobuf:"bytes,7,opt,name=full_date,json=fullDate,proto3" json:"full_date,omitempty"`
+	Namespaces           []*NamespaceUsageGrowth `protobuf:"bytes,8,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *StorageForecast) Reset()         { *m = StorageForecast{} }
+func (m *StorageForecast) String() string { return proto.CompactTextString(m) }
+func (*StorageForecast) ProtoMessage()    {}
+func (*StorageForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_volume_manager_9a2f7373a7d876f4, []int{56}
+}
+func (m *StorageForecast) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StorageForecast.Unmarshal(m, b)
+}
+func (m *StorageForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StorageForecast.Marshal(b, m, deterministic)
+}
+func (dst *StorageForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageForecast.Merge(dst, src)
+}
+func (m *StorageForecast) XXX_Size() int {
+	return xxx_messageInfo_StorageForecast.Size(m)
+}
+func (m *StorageForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageForecast.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageForecast proto.InternalMessageInfo
+
+func (m *StorageForecast) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StorageForecast) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *StorageForecast) GetUsed() int64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *StorageForecast) GetSamples() int32 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *StorageForecast) GetGrowthPerDay() int64 {
+	if m != nil {
+		return m.GrowthPerDay
+	}
+	return 0
+}
+
+func (m *StorageForecast) GetDaysToFull() *wrappers.DoubleValue {
+	if m != nil {
+		return m.DaysToFull
+	}
+	return nil
+}
+
+func (m *StorageForecast) GetFullDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.FullDate
+	}
+	return nil
+}
+
+func (m *StorageForecast) GetNamespaces() []*NamespaceUsageGrowth {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Error)(nil), "volumemanager.Error")
+	proto.RegisterMapType((map[string]string)(nil), "volumemanager.Error.FieldsEntry")
+	proto.RegisterType((*PageRequest)(nil), "volumemanager.PageRequest")
+	proto.RegisterType((*Pagination)(nil), "volumemanager.Pagination")
+	proto.RegisterType((*Volume)(nil), "volumemanager.Volume")
+	proto.RegisterMapType((map[string]string)(nil), "volumemanager.Volume.MetadataEntry")
+	proto.RegisterType((*VolumeRef)(nil), "volumemanager.VolumeRef")
+	proto.RegisterType((*CreateVolumeRequest)(nil), "volumemanager.CreateVolumeRequest")
+	proto.RegisterType((*DirectCreateVolumeRequest)(nil), "volumemanager.DirectCreateVolumeRequest")
+	proto.RegisterType((*ResizeVolumeRequest)(nil), "volumemanager.ResizeVolumeRequest")
+	proto.RegisterType((*AdminResizeVolumeRequest)(nil), "volumemanager.AdminResizeVolumeRequest")
+	proto.RegisterType((*PatchVolumeRequest)(nil), "volumemanager.PatchVolumeRequest")
+	proto.RegisterType((*ListNamespaceVolumesRequest)(nil), "volumemanager.ListNamespaceVolumesRequest")
+	proto.RegisterType((*ListUserVolumesRequest)(nil), "volumemanager.ListUserVolumesRequest")
+	proto.RegisterType((*ListAllVolumesRequest)(nil), "volumemanager.ListAllVolumesRequest")
+	proto.RegisterType((*VolumesResponse)(nil), "volumemanager.VolumesResponse")
+	proto.RegisterType((*DeleteNamespaceVolumesRequest)(nil), "volumemanager.DeleteNamespaceVolumesRequest")
+	proto.RegisterType((*Empty)(nil), "volumemanager.Empty")
+	proto.RegisterType((*QuoteVolumeRequest)(nil), "volumemanager.QuoteVolumeRequest")
+	proto.RegisterType((*VolumeQuote)(nil), "volumemanager.VolumeQuote")
+	proto.RegisterType((*ImportVolume)(nil), "volumemanager.ImportVolume")
+	proto.RegisterType((*ImportVolumesRequest)(nil), "volumemanager.ImportVolumesRequest")
+	proto.RegisterType((*ImportResult)(nil), "volumemanager.ImportResult")
+	proto.RegisterType((*ImportResponse)(nil), "volumemanager.ImportResponse")
+	proto.RegisterType((*KubeVolume)(nil), "volumemanager.KubeVolume")
+	proto.RegisterType((*VolumeViews)(nil), "volumemanager.VolumeViews")
+	proto.RegisterType((*GetNamespaceVolumeViewsRequest)(nil), "volumemanager.GetNamespaceVolumeViewsRequest")
+	proto.RegisterType((*NamespaceVolumeViews)(nil), "volumemanager.NamespaceVolumeViews")
+	proto.RegisterType((*ReconcileBillingRequest)(nil), "volumemanager.ReconcileBillingRequest")
+	proto.RegisterType((*BillingSubscription)(nil), "volumemanager.BillingSubscription")
+	proto.RegisterType((*ReconciliationVolume)(nil), "volumemanager.ReconciliationVolume")
+	proto.RegisterType((*TariffMismatch)(nil), "volumemanager.TariffMismatch")
+	proto.RegisterType((*BillingReconciliationReport)(nil), "volumemanager.BillingReconciliationReport")
+	proto.RegisterType((*MigrateTariffRequest)(nil), "volumemanager.MigrateTariffRequest")
+	proto.RegisterType((*TariffMigrationResult)(nil), "volumemanager.TariffMigrationResult")
+	proto.RegisterType((*TariffMigrationReport)(nil), "volumemanager.TariffMigrationReport")
+	proto.RegisterType((*GetMeteringRequest)(nil), "volumemanager.GetMeteringRequest")
+	proto.RegisterType((*MeteringRecord)(nil), "volumemanager.MeteringRecord")
+	proto.RegisterType((*MeteringReport)(nil), "volumemanager.MeteringReport")
+	proto.RegisterMapType((map[string]float64)(nil), "volumemanager.MeteringReport.ByNamespaceEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "volumemanager.MeteringReport.ByStorageEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "volumemanager.MeteringReport.ByTariffEntry")
+	proto.RegisterType((*StorageAccess)(nil), "volumemanager.StorageAccess")
+	proto.RegisterType((*Storage)(nil), "volumemanager.Storage")
+	proto.RegisterMapType((map[string]string)(nil), "volumemanager.Storage.AttributesEntry")
+	proto.RegisterType((*StorageRef)(nil), "volumemanager.StorageRef")
+	proto.RegisterType((*ListStoragesRequest)(nil), "volumemanager.ListStoragesRequest")
+	proto.RegisterType((*ListNamespaceStoragesRequest)(nil), "volumemanager.ListNamespaceStoragesRequest")
+	proto.RegisterType((*StoragesResponse)(nil), "volumemanager.StoragesResponse")
+	proto.RegisterType((*GetStorageRequest)(nil), "volumemanager.GetStorageRequest")
+	proto.RegisterType((*NamespaceStorageUsage)(nil), "volumemanager.NamespaceStorageUsage")
+	proto.RegisterType((*StorageDetails)(nil), "volumemanager.StorageDetails")
+	proto.RegisterType((*UpdateStorageRequest)(nil), "volumemanager.UpdateStorageRequest")
+	proto.RegisterMapType((map[string]string)(nil), "volumemanager.UpdateStorageRequest.AttributesEntry")
+	proto.RegisterType((*StorageImport)(nil), "volumemanager.StorageImport")
+	proto.RegisterMapType((map[string]string)(nil), "volumemanager.StorageImport.AttributesEntry")
+	proto.RegisterType((*ImportStoragesRequest)(nil), "volumemanager.ImportStoragesRequest")
+	proto.RegisterType((*RecalculateStoragesUsageRequest)(nil), "volumemanager.RecalculateStoragesUsageRequest")
+	proto.RegisterType((*StorageUsageCheck)(nil), "volumemanager.StorageUsageCheck")
+	proto.RegisterType((*StorageUsageReport)(nil), "volumemanager.StorageUsageReport")
+	proto.RegisterType((*SyncStoragesRequest)(nil), "volumemanager.SyncStoragesRequest")
+	proto.RegisterType((*StorageSyncReport)(nil), "volumemanager.StorageSyncReport")
+	proto.RegisterType((*GetStorageForecastRequest)(nil), "volumemanager.GetStorageForecastRequest")
+	proto.RegisterType((*NamespaceUsageGrowth)(nil), "volumemanager.NamespaceUsageGrowth")
+	proto.RegisterType((*StorageForecast)(nil), "volumemanager.StorageForecast")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// VolumesClient is the client API for Volumes service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type VolumesClient interface {
+	CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*Empty, error)
+	DirectCreateVolume(ctx context.Context, in *DirectCreateVolumeRequest, opts ...grpc.CallOption) (*Empty, error)
+	QuoteVolume(ctx context.Context, in *QuoteVolumeRequest, opts ...grpc.CallOption) (*VolumeQuote, error)
+	ImportVolumes(ctx context.Context, in *ImportVolumesRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	GetVolume(ctx context.Context, in *VolumeRef, opts ...grpc.CallOption) (*Volume, error)
+	ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*Empty, error)
+	AdminResizeVolume(ctx context.Context, in *AdminResizeVolumeRequest, opts ...grpc.CallOption) (*Empty, error)
+	PatchVolume(ctx context.Context, in *PatchVolumeRequest, opts ...grpc.CallOption) (*Volume, error)
+	ListNamespaceVolumes(ctx context.Context, in *ListNamespaceVolumesRequest, opts ...grpc.CallOption) (*VolumesResponse, error)
+	ListUserVolumes(ctx context.Context, in *ListUserVolumesRequest, opts ...grpc.CallOption) (*VolumesResponse, error)
+	ListAllVolumes(ctx context.Context, in *ListAllVolumesRequest, opts ...grpc.CallOption) (*VolumesResponse, error)
+	GetVolumeViews(ctx context.Context, in *VolumeRef, opts ...grpc.CallOption) (*VolumeViews, error)
+	GetNamespaceVolumeViews(ctx context.Context, in *GetNamespaceVolumeViewsRequest, opts ...grpc.CallOption) (*NamespaceVolumeViews, error)
+	DeleteVolume(ctx context.Context, in *VolumeRef, opts ...grpc.CallOption) (*Empty, error)
+	DeleteNamespaceVolumes(ctx context.Context, in *DeleteNamespaceVolumesRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteUserVolumes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error)
+	ReconcileBilling(ctx context.Context, in *ReconcileBillingRequest, opts ...grpc.CallOption) (*BillingReconciliationReport, error)
+	MigrateTariff(ctx context.Context, in *MigrateTariffRequest, opts ...grpc.CallOption) (*TariffMigrationReport, error)
+	GetMetering(ctx context.Context, in *GetMeteringRequest, opts ...grpc.CallOption) (*MeteringReport, error)
+}
+
+type volumesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewVolumesClient(cc *grpc.ClientConn) VolumesClient {
+	return &volumesClient{cc}
+}
+
+func (c *volumesClient) CreateVolume(ctx context.Context, in *CreateVolumeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/CreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) DirectCreateVolume(ctx context.Context, in *DirectCreateVolumeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/DirectCreateVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) QuoteVolume(ctx context.Context, in *QuoteVolumeRequest, opts ...grpc.CallOption) (*VolumeQuote, error) {
+	out := new(VolumeQuote)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/QuoteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) ImportVolumes(ctx context.Context, in *ImportVolumesRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/ImportVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) GetVolume(ctx context.Context, in *VolumeRef, opts ...grpc.CallOption) (*Volume, error) {
+	out := new(Volume)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/GetVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) ResizeVolume(ctx context.Context, in *ResizeVolumeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/ResizeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) AdminResizeVolume(ctx context.Context, in *AdminResizeVolumeRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/AdminResizeVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) PatchVolume(ctx context.Context, in *PatchVolumeRequest, opts ...grpc.CallOption) (*Volume, error) {
+	out := new(Volume)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/PatchVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) ListNamespaceVolumes(ctx context.Context, in *ListNamespaceVolumesRequest, opts ...grpc.CallOption) (*VolumesResponse, error) {
+	out := new(VolumesResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/ListNamespaceVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) ListUserVolumes(ctx context.Context, in *ListUserVolumesRequest, opts ...grpc.CallOption) (*VolumesResponse, error) {
+	out := new(VolumesResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/ListUserVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) ListAllVolumes(ctx context.Context, in *ListAllVolumesRequest, opts ...grpc.CallOption) (*VolumesResponse, error) {
+	out := new(VolumesResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/ListAllVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) GetVolumeViews(ctx context.Context, in *VolumeRef, opts ...grpc.CallOption) (*VolumeViews, error) {
+	out := new(VolumeViews)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/GetVolumeViews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) GetNamespaceVolumeViews(ctx context.Context, in *GetNamespaceVolumeViewsRequest, opts ...grpc.CallOption) (*NamespaceVolumeViews, error) {
+	out := new(NamespaceVolumeViews)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/GetNamespaceVolumeViews", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) DeleteVolume(ctx context.Context, in *VolumeRef, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/DeleteVolume", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) DeleteNamespaceVolumes(ctx context.Context, in *DeleteNamespaceVolumesRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/DeleteNamespaceVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) DeleteUserVolumes(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/DeleteUserVolumes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) ReconcileBilling(ctx context.Context, in *ReconcileBillingRequest, opts ...grpc.CallOption) (*BillingReconciliationReport, error) {
+	out := new(BillingReconciliationReport)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/ReconcileBilling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) MigrateTariff(ctx context.Context, in *MigrateTariffRequest, opts ...grpc.CallOption) (*TariffMigrationReport, error) {
+	out := new(TariffMigrationReport)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/MigrateTariff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *volumesClient) GetMetering(ctx context.Context, in *GetMeteringRequest, opts ...grpc.CallOption) (*MeteringReport, error) {
+	out := new(MeteringReport)
+	err := c.cc.Invoke(ctx, "/volumemanager.Volumes/GetMetering", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VolumesServer is the server API for Volumes service.
+type VolumesServer interface {
+	CreateVolume(context.Context, *CreateVolumeRequest) (*Empty, error)
+	DirectCreateVolume(context.Context, *DirectCreateVolumeRequest) (*Empty, error)
+	QuoteVolume(context.Context, *QuoteVolumeRequest) (*VolumeQuote, error)
+	ImportVolumes(context.Context, *ImportVolumesRequest) (*ImportResponse, error)
+	GetVolume(context.Context, *VolumeRef) (*Volume, error)
+	ResizeVolume(context.Context, *ResizeVolumeRequest) (*Empty, error)
+	AdminResizeVolume(context.Context, *AdminResizeVolumeRequest) (*Empty, error)
+	PatchVolume(context.Context, *PatchVolumeRequest) (*Volume, error)
+	ListNamespaceVolumes(context.Context, *ListNamespaceVolumesRequest) (*VolumesResponse, error)
+	ListUserVolumes(context.Context, *ListUserVolumesRequest) (*VolumesResponse, error)
+	ListAllVolumes(context.Context, *ListAllVolumesRequest) (*VolumesResponse, error)
+	GetVolumeViews(context.Context, *VolumeRef) (*VolumeViews, error)
+	GetNamespaceVolumeViews(context.Context, *GetNamespaceVolumeViewsRequest) (*NamespaceVolumeViews, error)
+	DeleteVolume(context.Context, *VolumeRef) (*Empty, error)
+	DeleteNamespaceVolumes(context.Context, *DeleteNamespaceVolumesRequest) (*Empty, error)
+	DeleteUserVolumes(context.Context, *Empty) (*Empty, error)
+	ReconcileBilling(context.Context, *ReconcileBillingRequest) (*BillingReconciliationReport, error)
+	MigrateTariff(context.Context, *MigrateTariffRequest) (*TariffMigrationReport, error)
+	GetMetering(context.Context, *GetMeteringRequest) (*MeteringReport, error)
+}
+
+func RegisterVolumesServer(s *grpc.Server, srv VolumesServer) {
+	s.RegisterService(&_Volumes_serviceDesc, srv)
+}
+
+func _Volumes_CreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).CreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/CreateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).CreateVolume(ctx, req.(*CreateVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_DirectCreateVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DirectCreateVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).DirectCreateVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/DirectCreateVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).DirectCreateVolume(ctx, req.(*DirectCreateVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_QuoteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuoteVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).QuoteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/QuoteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).QuoteVolume(ctx, req.(*QuoteVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_ImportVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).ImportVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/ImportVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).ImportVolumes(ctx, req.(*ImportVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_GetVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).GetVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/GetVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).GetVolume(ctx, req.(*VolumeRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_ResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).ResizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/ResizeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).ResizeVolume(ctx, req.(*ResizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_AdminResizeVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminResizeVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).AdminResizeVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/AdminResizeVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).AdminResizeVolume(ctx, req.(*AdminResizeVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_PatchVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchVolumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).PatchVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/PatchVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).PatchVolume(ctx, req.(*PatchVolumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_ListNamespaceVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).ListNamespaceVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/ListNamespaceVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).ListNamespaceVolumes(ctx, req.(*ListNamespaceVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_ListUserVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).ListUserVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/ListUserVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).ListUserVolumes(ctx, req.(*ListUserVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_ListAllVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).ListAllVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/ListAllVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).ListAllVolumes(ctx, req.(*ListAllVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_GetVolumeViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).GetVolumeViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/GetVolumeViews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).GetVolumeViews(ctx, req.(*VolumeRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_GetNamespaceVolumeViews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceVolumeViewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).GetNamespaceVolumeViews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/GetNamespaceVolumeViews",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).GetNamespaceVolumeViews(ctx, req.(*GetNamespaceVolumeViewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_DeleteVolume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VolumeRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).DeleteVolume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/DeleteVolume",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).DeleteVolume(ctx, req.(*VolumeRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_DeleteNamespaceVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceVolumesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).DeleteNamespaceVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/DeleteNamespaceVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).DeleteNamespaceVolumes(ctx, req.(*DeleteNamespaceVolumesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_DeleteUserVolumes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).DeleteUserVolumes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/DeleteUserVolumes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).DeleteUserVolumes(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_ReconcileBilling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileBillingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).ReconcileBilling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/ReconcileBilling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).ReconcileBilling(ctx, req.(*ReconcileBillingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_MigrateTariff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateTariffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).MigrateTariff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/MigrateTariff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).MigrateTariff(ctx, req.(*MigrateTariffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Volumes_GetMetering_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMeteringRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VolumesServer).GetMetering(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Volumes/GetMetering",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VolumesServer).GetMetering(ctx, req.(*GetMeteringRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Volumes_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "volumemanager.Volumes",
+	HandlerType: (*VolumesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateVolume",
+			Handler:    _Volumes_CreateVolume_Handler,
+		},
+		{
+			MethodName: "DirectCreateVolume",
+			Handler:    _Volumes_DirectCreateVolume_Handler,
+		},
+		{
+			MethodName: "QuoteVolume",
+			Handler:    _Volumes_QuoteVolume_Handler,
+		},
+		{
+			MethodName: "ImportVolumes",
+			Handler:    _Volumes_ImportVolumes_Handler,
+		},
+		{
+			MethodName: "GetVolume",
+			Handler:    _Volumes_GetVolume_Handler,
+		},
+		{
+			MethodName: "ResizeVolume",
+			Handler:    _Volumes_ResizeVolume_Handler,
+		},
+		{
+			MethodName: "AdminResizeVolume",
+			Handler:    _Volumes_AdminResizeVolume_Handler,
+		},
+		{
+			MethodName: "PatchVolume",
+			Handler:    _Volumes_PatchVolume_Handler,
+		},
+		{
+			MethodName: "ListNamespaceVolumes",
+			Handler:    _Volumes_ListNamespaceVolumes_Handler,
+		},
+		{
+			MethodName: "ListUserVolumes",
+			Handler:    _Volumes_ListUserVolumes_Handler,
+		},
+		{
+			MethodName: "ListAllVolumes",
+			Handler:    _Volumes_ListAllVolumes_Handler,
+		},
+		{
+			MethodName: "GetVolumeViews",
+			Handler:    _Volumes_GetVolumeViews_Handler,
+		},
+		{
+			MethodName: "GetNamespaceVolumeViews",
+			Handler:    _Volumes_GetNamespaceVolumeViews_Handler,
+		},
+		{
+			MethodName: "DeleteVolume",
+			Handler:    _Volumes_DeleteVolume_Handler,
+		},
+		{
+			MethodName: "DeleteNamespaceVolumes",
+			Handler:    _Volumes_DeleteNamespaceVolumes_Handler,
+		},
+		{
+			MethodName: "DeleteUserVolumes",
+			Handler:    _Volumes_DeleteUserVolumes_Handler,
+		},
+		{
+			MethodName: "ReconcileBilling",
+			Handler:    _Volumes_ReconcileBilling_Handler,
+		},
+		{
+			MethodName: "MigrateTariff",
+			Handler:    _Volumes_MigrateTariff_Handler,
+		},
+		{
+			MethodName: "GetMetering",
+			Handler:    _Volumes_GetMetering_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "volume_manager.proto",
+}
+
+// StoragesClient is the client API for Storages service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StoragesClient interface {
+	CreateStorage(ctx context.Context, in *Storage, opts ...grpc.CallOption) (*Empty, error)
+	ImportStorages(ctx context.Context, in *ImportStoragesRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	GetStorage(ctx context.Context, in *GetStorageRequest, opts ...grpc.CallOption) (*StorageDetails, error)
+	ListStorages(ctx context.Context, in *ListStoragesRequest, opts ...grpc.CallOption) (*StoragesResponse, error)
+	ListNamespaceStorages(ctx context.Context, in *ListNamespaceStoragesRequest, opts ...grpc.CallOption) (*StoragesResponse, error)
+	UpdateStorage(ctx context.Context, in *UpdateStorageRequest, opts ...grpc.CallOption) (*Empty, error)
+	DeleteStorage(ctx context.Context, in *StorageRef, opts ...grpc.CallOption) (*Empty, error)
+	RecalculateStoragesUsage(ctx context.Context, in *RecalculateStoragesUsageRequest, opts ...grpc.CallOption) (*StorageUsageReport, error)
+	SyncStorages(ctx context.Context, in *SyncStoragesRequest, opts ...grpc.CallOption) (*StorageSyncReport, error)
+	GetStorageForecast(ctx context.Context, in *GetStorageForecastRequest, opts ...grpc.CallOption) (*StorageForecast, error)
+}
+
+type storagesClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStoragesClient(cc *grpc.ClientConn) StoragesClient {
+	return &storagesClient{cc}
+}
+
+func (c *storagesClient) CreateStorage(ctx context.Context, in *Storage, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/CreateStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) ImportStorages(ctx context.Context, in *ImportStoragesRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/ImportStorages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) GetStorage(ctx context.Context, in *GetStorageRequest, opts ...grpc.CallOption) (*StorageDetails, error) {
+	out := new(StorageDetails)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/GetStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) ListStorages(ctx context.Context, in *ListStoragesRequest, opts ...grpc.CallOption) (*StoragesResponse, error) {
+	out := new(StoragesResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/ListStorages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) ListNamespaceStorages(ctx context.Context, in *ListNamespaceStoragesRequest, opts ...grpc.CallOption) (*StoragesResponse, error) {
+	out := new(StoragesResponse)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/ListNamespaceStorages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) UpdateStorage(ctx context.Context, in *UpdateStorageRequest, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/UpdateStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) DeleteStorage(ctx context.Context, in *StorageRef, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/DeleteStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) RecalculateStoragesUsage(ctx context.Context, in *RecalculateStoragesUsageRequest, opts ...grpc.CallOption) (*StorageUsageReport, error) {
+	out := new(StorageUsageReport)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/RecalculateStoragesUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) SyncStorages(ctx context.Context, in *SyncStoragesRequest, opts ...grpc.CallOption) (*StorageSyncReport, error) {
+	out := new(StorageSyncReport)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/SyncStorages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *storagesClient) GetStorageForecast(ctx context.Context, in *GetStorageForecastRequest, opts ...grpc.CallOption) (*StorageForecast, error) {
+	out := new(StorageForecast)
+	err := c.cc.Invoke(ctx, "/volumemanager.Storages/GetStorageForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StoragesServer is the server API for Storages service.
+type StoragesServer interface {
+	CreateStorage(context.Context, *Storage) (*Empty, error)
+	ImportStorages(context.Context, *ImportStoragesRequest) (*ImportResponse, error)
+	GetStorage(context.Context, *GetStorageRequest) (*StorageDetails, error)
+	ListStorages(context.Context, *ListStoragesRequest) (*StoragesResponse, error)
+	ListNamespaceStorages(context.Context, *ListNamespaceStoragesRequest) (*StoragesResponse, error)
+	UpdateStorage(context.Context, *UpdateStorageRequest) (*Empty, error)
+	DeleteStorage(context.Context, *StorageRef) (*Empty, error)
+	RecalculateStoragesUsage(context.Context, *RecalculateStoragesUsageRequest) (*StorageUsageReport, error)
+	SyncStorages(context.Context, *SyncStoragesRequest) (*StorageSyncReport, error)
+	GetStorageForecast(context.Context, *GetStorageForecastRequest) (*StorageForecast, error)
+}
+
+func RegisterStoragesServer(s *grpc.Server, srv StoragesServer) {
+	s.RegisterService(&_Storages_serviceDesc, srv)
+}
+
+func _Storages_CreateStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Storage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).CreateStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/CreateStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).CreateStorage(ctx, req.(*Storage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_ImportStorages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportStoragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).ImportStorages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/ImportStorages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).ImportStorages(ctx, req.(*ImportStoragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_GetStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).GetStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/GetStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).GetStorage(ctx, req.(*GetStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_ListStorages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStoragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).ListStorages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/ListStorages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).ListStorages(ctx, req.(*ListStoragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_ListNamespaceStorages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceStoragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).ListNamespaceStorages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/ListNamespaceStorages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).ListNamespaceStorages(ctx, req.(*ListNamespaceStoragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_UpdateStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).UpdateStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/UpdateStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).UpdateStorage(ctx, req.(*UpdateStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_DeleteStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StorageRef)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).DeleteStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/DeleteStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).DeleteStorage(ctx, req.(*StorageRef))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_RecalculateStoragesUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecalculateStoragesUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).RecalculateStoragesUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/RecalculateStoragesUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).RecalculateStoragesUsage(ctx, req.(*RecalculateStoragesUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_SyncStorages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncStoragesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).SyncStorages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/SyncStorages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).SyncStorages(ctx, req.(*SyncStoragesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Storages_GetStorageForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStorageForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StoragesServer).GetStorageForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/volumemanager.Storages/GetStorageForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StoragesServer).GetStorageForecast(ctx, req.(*GetStorageForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Storages_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "volumemanager.Storages",
+	HandlerType: (*StoragesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateStorage",
+			Handler:    _Storages_CreateStorage_Handler,
+		},
+		{
+			MethodName: "ImportStorages",
+			Handler:    _Storages_ImportStorages_Handler,
+		},
+		{
+			MethodName: "GetStorage",
+			Handler:    _Storages_GetStorage_Handler,
+		},
+		{
+			MethodName: "ListStorages",
+			Handler:    _Storages_ListStorages_Handler,
+		},
+		{
+			MethodName: "ListNamespaceStorages",
+			Handler:    _Storages_ListNamespaceStorages_Handler,
+		},
+		{
+			MethodName: "UpdateStorage",
+			Handler:    _Storages_UpdateStorage_Handler,
+		},
+		{
+			MethodName: "DeleteStorage",
+			Handler:    _Storages_DeleteStorage_Handler,
+		},
+		{
+			MethodName: "RecalculateStoragesUsage",
+			Handler:    _Storages_RecalculateStoragesUsage_Handler,
+		},
+		{
+			MethodName: "SyncStorages",
+			Handler:    _Storages_SyncStorages_Handler,
+		},
+		{
+			MethodName: "GetStorageForecast",
+			Handler:    _Storages_GetStorageForecast_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "volume_manager.proto",
+}
+
+func init() {
+	proto.RegisterFile("volume_manager.proto", fileDescriptor_volume_manager_9a2f7373a7d876f4)
+}
+
+var fileDescriptor_volume_manager_9a2f7373a7d876f4 = []byte{
+	// 3263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x1a, 0x4d, 0x93, 0x1b, 0x47,
+	0xb5, 0x46, 0xd2, 0xea, 0xe3, 0x49, 0x5a, 0xef, 0xb6, 0xd7, 0xeb, 0xb1, 0xd6, 0x6b, 0xaf, 0xc7,
+	0x4e, 0x58, 0xe2, 0x44, 0x4e, 0x6d, 0x0c, 0xce, 0xa7, 0x83, 0xbd, 0xeb, 0xaf, 0xc4, 0x1b, 0x9c,
+	0xd9, 0xb5, 0x43, 0x05, 0x8a, 0xa9, 0x91, 0xa6, 0xa5, 0x1d, 0x3c, 0xd2, 0x4c, 0x66, 0x7a, 0xb2,
+	0x51, 0x28, 0xa8, 0x0a, 0x14, 0x14, 0x70, 0xe1, 0xc6, 0x89, 0x03, 0x47, 0x8a, 0x2a, 0x8a, 0x2a,
+	0x4e, 0xdc, 0x39, 0xf2, 0x07, 0x38, 0x51, 0xfc, 0x07, 0x8a, 0x13, 0x45, 0x15, 0xd5, 0x1f, 0x33,
+	0xea, 0xf9, 0x92, 0xb4, 0x76, 0xc2, 0x49, 0xea, 0x37, 0xaf, 0xdf, 0xeb, 0xf7, 0xd1, 0xef, 0xbd,
+	0x7e, 0xdd, 0xb0, 0xf6, 0xa9, 0xeb, 0x84, 0x23, 0x6c, 0x8c, 0xcc, 0xb1, 0x39, 0xc4, 0x7e, 0xd7,
+	0xf3, 0x5d, 0xe2, 0xa2, 0x36, 0x87, 0x0a, 0x60, 0xe7, 0xe2, 0xd0, 0x75, 0x87, 0x0e, 0xbe, 0xc6,
+	0x3e, 0xf6, 0xc2, 0xc1, 0x35, 0x62, 0x8f, 0x70, 0x40, 0xcc, 0x91, 0xc7, 0xf1, 0x3b, 0x17, 0xd2,
+	0x08, 0xc7, 0xbe, 0xe9, 0x79, 0xd8, 0x0f, 0xf8, 0x77, 0xed, 0x97, 0x25, 0x58, 0xba, 0xe3, 0xfb,
+	0xae, 0x8f, 0xce, 0x42, 0xcd, 0xb6, 0x8c, 0xa7, 0xf6, 0xd8, 0x52, 0x95, 0x2d, 0x65, 0xbb, 0xa2,
+	0x57, 0x6d, 0xeb, 0x7d, 0x7b, 0x6c, 0xa1, 0x4d, 0x00, 0xdb, 0x32, 0x02, 0xec, 0x7f, 0x6a, 0xf7,
+	0xb1, 0x5a, 0xda, 0x52, 0xb6, 0x1b, 0x7a, 0xc3, 0xb6, 0x0e, 0x38, 0x00, 0xa9, 0x50, 0x1b, 0xe1,
+	0x20, 0x30, 0x87, 0x58, 0x2d, 0xb3, 0x6f, 0xd1, 0x90, 0x7e, 0xb1, 0x30, 0x31, 0x6d, 0x27, 0x50,
+	0x2b, 0x5b, 0x65, 0xfa, 0x45, 0x0c, 0xd1, 0x45, 0x68, 0x06, 0xc4, 0x24, 0x61, 0x60, 0x1c, 0x11,
+	0xe2, 0xa9, 0x4b, 0x5b, 0xca, 0xf6, 0x92, 0x0e, 0x1c, 0x74, 0x9f, 0x10, 0x0f, 0xbd, 0x0e, 0xd5,
+	0x81, 0x8d, 0x1d, 0x2b, 0x50, 0xab, 0x5b, 0xe5, 0xed, 0xe6, 0xce, 0x56, 0x37, 0x21, 0x77, 0x97,
+	0x2d, 0xb9, 0x7b, 0x97, 0xa1, 0xdc, 0x19, 0x13, 0x7f, 0xa2, 0x0b, 0xfc, 0xce, 0x1b, 0xd0, 0x94,
+	0xc0, 0x68, 0x05, 0xca, 0x4f, 0xf1, 0x84, 0x49, 0xd4, 0xd0, 0xe9, 0x5f, 0xb4, 0x06, 0x4b, 0x9f,
+	0x9a, 0x4e, 0x18, 0x49, 0xc2, 0x07, 0x6f, 0x96, 0x5e, 0x57, 0xb4, 0x43, 0x68, 0x3e, 0x32, 0x87,
+	0x58, 0xc7, 0x9f, 0x84, 0x38, 0x20, 0x08, 0x41, 0xc5, 0xa3, 0x52, 0x29, 0x6c, 0x75, 0xec, 0x3f,
+	0x3a, 0x07, 0x75, 0x0f, 0xfb, 0x06, 0x83, 0x97, 0x18, 0xbc, 0xe6, 0x61, 0x9f, 0xce, 0x42, 0xeb,
+	0x50, 0xed, 0x87, 0x7e, 0xe0, 0xfa, 0x42, 0x0d, 0x62, 0xa4, 0xf9, 0x00, 0x8f, 0xcc, 0xa1, 0x3d,
+	0x36, 0x89, 0xed, 0x8e, 0x29, 0x77, 0xe2, 0x12, 0xd3, 0x11, 0x54, 0xf9, 0x20, 0x66, 0x55, 0x2a,
+	0x60, 0x55, 0x4e, 0xb2, 0xba, 0x08, 0xcd, 0x31, 0xfe, 0x8c, 0x18, 0x82, 0x5f, 0x85, 0xf1, 0x03,
+	0x0a, 0xda, 0xe5, 0x3c, 0x7f, 0x51, 0x86, 0xea, 0x13, 0xa6, 0x30, 0xca, 0xd0, 0x31, 0x7b, 0xd8,
+	0x11, 0x2a, 0xe0, 0x03, 0xf4, 0x16, 0x34, 0xfb, 0x3e, 0x36, 0x09, 0x36, 0xa8, 0xc3, 0x30, 0xbe,
+	0xcd, 0x9d, 0x4e, 0x97, 0x3b, 0x4b, 0x37, 0x72, 0x96, 0xee, 0x61, 0xe4, 0x4d, 0x3a, 0x70, 0x74,
+	0x0a, 0x40, 0x1b, 0xd0, 0x20, 0xa6, 0x6f, 0x0f, 0x06, 0x86, 0x6d, 0x09, 0x61, 0xeb, 0x1c, 0xf0,
+	0xc0, 0x42, 0x1d, 0xa8, 0xf7, 0x4d, 0xcf, 0xec, 0xdb, 0x64, 0xc2, 0x16, 0x56, 0xd6, 0xe3, 0x31,
+	0xba, 0x04, 0xad, 0x80, 0xb8, 0xbe, 0x39, 0xc4, 0xc6, 0xd8, 0x1c, 0x61, 0x66, 0xf7, 0x86, 0xde,
+	0x14, 0xb0, 0x0f, 0xcc, 0x11, 0x13, 0xcd, 0xec, 0xf7, 0x71, 0x10, 0x18, 0x23, 0xd7, 0xc2, 0x6a,
+	0x95, 0x8b, 0xc6, 0x41, 0xfb, 0xae, 0xc5, 0xd4, 0xcc, 0xfd, 0x44, 0xad, 0x71, 0x35, 0xf3, 0x11,
+	0x75, 0xb6, 0xbe, 0x13, 0x06, 0x04, 0xfb, 0x6a, 0x9d, 0xbb, 0xa1, 0x18, 0xa2, 0x77, 0xa1, 0x3e,
+	0xc2, 0xc4, 0xb4, 0x4c, 0x62, 0xaa, 0x0d, 0xe6, 0x4d, 0x97, 0x53, 0xde, 0xc4, 0x55, 0xd5, 0xdd,
+	0x17, 0x58, 0xdc, 0xa1, 0xe2, 0x49, 0x9d, 0xb7, 0xa0, 0x9d, 0xf8, 0x74, 0x22, 0xa7, 0xda, 0x83,
+	0x06, 0x27, 0xaf, 0xe3, 0x01, 0x55, 0x00, 0x15, 0x3c, 0xf0, 0xcc, 0x3e, 0xa6, 0xca, 0xe3, 0x14,
+	0x9a, 0x31, 0xec, 0x81, 0x35, 0xb5, 0x57, 0x49, 0xb2, 0x97, 0xf6, 0x33, 0x05, 0x4e, 0xef, 0x32,
+	0x0b, 0x44, 0xc4, 0xb8, 0x8f, 0x2e, 0x40, 0x30, 0x61, 0xad, 0x52, 0xca, 0x5a, 0x31, 0xb7, 0xb2,
+	0xec, 0x1d, 0x2a, 0xd4, 0x84, 0x4d, 0x84, 0x6f, 0x45, 0x43, 0xed, 0x57, 0x0a, 0x9c, 0xdb, 0xb3,
+	0x7d, 0xdc, 0x27, 0xcf, 0xb8, 0x9a, 0x5c, 0xf1, 0x12, 0x4e, 0x53, 0x4e, 0x39, 0x4d, 0xf1, 0x62,
+	0x2c, 0x38, 0xad, 0xe3, 0xc0, 0xfe, 0x3c, 0xb5, 0x8a, 0x57, 0xa1, 0xca, 0xcd, 0xcb, 0xf8, 0x37,
+	0x77, 0xd4, 0x5c, 0x6b, 0xeb, 0x78, 0xa0, 0x0b, 0xbc, 0x99, 0x2a, 0xd2, 0x8e, 0x40, 0xbd, 0x65,
+	0x8d, 0xec, 0xf1, 0x97, 0xc3, 0x4a, 0x96, 0xb4, 0x94, 0x94, 0x54, 0x1b, 0x02, 0x7a, 0x64, 0x92,
+	0xfe, 0xd1, 0xf3, 0xf2, 0xb8, 0x08, 0xcd, 0x11, 0xf6, 0x87, 0xd8, 0xf0, 0x28, 0x35, 0xc6, 0xa6,
+	0xa5, 0x03, 0x03, 0x31, 0xfa, 0x9a, 0x07, 0x1b, 0x0f, 0xed, 0x80, 0x7c, 0x10, 0xd9, 0x85, 0x93,
+	0x08, 0x4e, 0x60, 0xc6, 0xae, 0x14, 0xb0, 0x68, 0xe0, 0x48, 0x2e, 0x49, 0x8a, 0xa2, 0x3c, 0x98,
+	0x69, 0xf7, 0x61, 0x9d, 0x72, 0x7c, 0x1c, 0x60, 0x3f, 0xc5, 0xac, 0x2b, 0x45, 0xd9, 0x45, 0x28,
+	0x85, 0x70, 0x86, 0x52, 0xba, 0xe5, 0x38, 0xcf, 0x47, 0x88, 0xc6, 0xdc, 0xc0, 0xf5, 0x89, 0x5a,
+	0x62, 0xa9, 0x89, 0xfd, 0xa7, 0xbe, 0x36, 0xb0, 0x1d, 0x82, 0xfd, 0x40, 0x2d, 0xf3, 0x8c, 0x25,
+	0x86, 0xda, 0x8f, 0xe0, 0x54, 0xcc, 0x2f, 0xf0, 0xdc, 0x71, 0x80, 0xd1, 0x35, 0xa8, 0x71, 0x1e,
+	0x81, 0xaa, 0xb0, 0xb0, 0x72, 0x26, 0xdf, 0x32, 0x11, 0x16, 0x7a, 0x03, 0xc0, 0x8b, 0x33, 0x81,
+	0x50, 0xdd, 0xb9, 0xec, 0x3a, 0x05, 0x82, 0x2e, 0x21, 0x6b, 0xb7, 0x61, 0x73, 0x0f, 0x3b, 0x98,
+	0xe0, 0x67, 0xb7, 0x99, 0x56, 0x83, 0xa5, 0x3b, 0x23, 0x8f, 0x4c, 0xb4, 0xdf, 0x2a, 0x80, 0x3e,
+	0x0c, 0xdd, 0x67, 0xd8, 0xbd, 0xeb, 0x50, 0x35, 0xfb, 0xf1, 0xea, 0x1b, 0xba, 0x18, 0xcd, 0xce,
+	0x08, 0xf1, 0x96, 0xaf, 0x14, 0xc4, 0x98, 0xa5, 0xe4, 0xb6, 0xfe, 0x63, 0x19, 0x9a, 0x7c, 0x65,
+	0x6c, 0x91, 0x12, 0x53, 0x25, 0xc1, 0xb4, 0x03, 0xf5, 0x01, 0x36, 0x03, 0xbb, 0xe7, 0x70, 0x3f,
+	0xac, 0xeb, 0xf1, 0x98, 0x52, 0xf7, 0xb1, 0x19, 0xb8, 0xe3, 0xd8, 0x90, 0x62, 0x38, 0x33, 0x3f,
+	0x7d, 0x1d, 0x56, 0xfa, 0xa1, 0xef, 0xe3, 0x31, 0x31, 0x62, 0x9c, 0x25, 0x86, 0x73, 0x4a, 0xc0,
+	0x77, 0x8b, 0x52, 0x59, 0x35, 0x9b, 0xca, 0x12, 0x4a, 0xa9, 0xa5, 0x94, 0x72, 0x09, 0x5a, 0xe2,
+	0x23, 0xd7, 0x0d, 0xcf, 0x59, 0x4d, 0x0e, 0x7b, 0xc8, 0x34, 0xb4, 0x06, 0x4b, 0x9e, 0x4f, 0x4b,
+	0xae, 0xc6, 0x96, 0xb2, 0xad, 0xe8, 0x7c, 0x40, 0xd7, 0x18, 0x31, 0x3e, 0xc2, 0xa6, 0xe5, 0xbb,
+	0xee, 0x48, 0x05, 0xbe, 0x46, 0x01, 0xbf, 0x2f, 0xc0, 0x34, 0x0e, 0x7c, 0x12, 0xba, 0xc4, 0x34,
+	0x1c, 0x7b, 0x64, 0x13, 0xb5, 0xc9, 0xb0, 0x80, 0x81, 0x1e, 0x52, 0x08, 0xad, 0xec, 0x38, 0x42,
+	0x18, 0x60, 0x4b, 0x6d, 0xb1, 0xef, 0x0d, 0x06, 0x79, 0x1c, 0x60, 0x0b, 0xbd, 0x00, 0xcb, 0xfc,
+	0x73, 0xcc, 0xa8, 0xcd, 0x50, 0xda, 0x0c, 0x1a, 0xb1, 0xd1, 0xfe, 0xa0, 0x40, 0xeb, 0xc1, 0xc8,
+	0x73, 0x7d, 0x22, 0x4a, 0x8e, 0xaf, 0x24, 0x0d, 0xa4, 0x15, 0x5e, 0xc9, 0x2a, 0x5c, 0x83, 0xb6,
+	0x7b, 0x3c, 0xc6, 0x3e, 0x15, 0xc7, 0xa7, 0x8c, 0x45, 0x7d, 0xc1, 0x80, 0x34, 0xf4, 0x3c, 0xb0,
+	0xb4, 0x7d, 0x58, 0x93, 0xd7, 0x1a, 0xef, 0x9f, 0x6f, 0xa4, 0x37, 0xf3, 0x46, 0x6a, 0x63, 0xca,
+	0xb3, 0xe2, 0x2d, 0xad, 0x7d, 0x1c, 0x89, 0xae, 0xe3, 0x20, 0x74, 0x58, 0xcd, 0xc8, 0x56, 0xc7,
+	0x45, 0x66, 0xff, 0xd1, 0x79, 0x68, 0xc4, 0xa2, 0x47, 0xe5, 0x73, 0x0c, 0x28, 0x2e, 0x9f, 0xb5,
+	0x1f, 0xc3, 0x72, 0x4c, 0x9b, 0x47, 0x9c, 0x1b, 0x50, 0xb7, 0x19, 0x04, 0x5b, 0x33, 0x57, 0xc9,
+	0x17, 0xa3, 0xc7, 0xc8, 0xe8, 0x35, 0xa8, 0x0e, 0x4c, 0xdb, 0xc1, 0x96, 0x5a, 0x9a, 0x3f, 0x4d,
+	0xa0, 0x6a, 0xff, 0x2d, 0x01, 0xbc, 0x1f, 0xf6, 0x44, 0x94, 0x78, 0x06, 0xd1, 0x52, 0x45, 0x66,
+	0xf9, 0x44, 0x45, 0xe6, 0x1b, 0x00, 0x3d, 0x37, 0x1c, 0x5b, 0x7c, 0x6e, 0x65, 0xee, 0xdc, 0x06,
+	0xc3, 0xce, 0xd6, 0xa7, 0x4b, 0x33, 0xea, 0xd3, 0xea, 0x1c, 0x1f, 0xab, 0xcd, 0xad, 0x4f, 0xeb,
+	0x33, 0xea, 0xd3, 0x46, 0xa2, 0x3e, 0x5d, 0x87, 0x2a, 0x0f, 0x41, 0x6c, 0xb7, 0x36, 0x74, 0x31,
+	0x92, 0xeb, 0xd6, 0x66, 0xa2, 0x6e, 0xd5, 0xfe, 0xa2, 0x44, 0x71, 0xf0, 0x89, 0x8d, 0x8f, 0x83,
+	0x82, 0x4a, 0xfe, 0x05, 0x28, 0x59, 0x3d, 0x91, 0x4c, 0x0a, 0x12, 0x50, 0xc9, 0xea, 0xa1, 0x57,
+	0xa0, 0xf2, 0x34, 0xec, 0x45, 0x46, 0x48, 0x67, 0x9d, 0xa9, 0x99, 0x75, 0x86, 0x46, 0x23, 0x03,
+	0xfd, 0x35, 0x30, 0x3d, 0x67, 0x89, 0xbd, 0xd6, 0xa0, 0x10, 0x7e, 0x56, 0xbc, 0x00, 0x30, 0xb2,
+	0x83, 0x11, 0x2d, 0x26, 0x70, 0xa0, 0x2e, 0xb1, 0x08, 0x2b, 0x41, 0xb4, 0x5d, 0xb8, 0x70, 0x0f,
+	0xa7, 0xeb, 0x0b, 0x26, 0xc5, 0x09, 0xf2, 0xd5, 0x43, 0x58, 0xcb, 0xa3, 0x80, 0xae, 0xa7, 0xb7,
+	0x6a, 0x27, 0x57, 0x6c, 0xce, 0x2e, 0xde, 0xa9, 0x57, 0xe1, 0xac, 0x8e, 0xfb, 0xee, 0xb8, 0x6f,
+	0x3b, 0xf8, 0xb6, 0xed, 0x38, 0xf6, 0x78, 0x18, 0xad, 0x65, 0x05, 0xca, 0x03, 0xfb, 0x33, 0xb6,
+	0x84, 0xba, 0x4e, 0xff, 0x6a, 0xff, 0x52, 0xe0, 0xb4, 0x40, 0x3a, 0x08, 0x7b, 0x41, 0xdf, 0xb7,
+	0x3d, 0x96, 0x72, 0x2e, 0x42, 0xd3, 0xc7, 0x81, 0x1b, 0xfa, 0xf2, 0xa2, 0x21, 0x02, 0x3d, 0x60,
+	0x21, 0x33, 0x46, 0x90, 0x03, 0x5c, 0x3b, 0x82, 0xf2, 0xd0, 0x7e, 0x19, 0x62, 0x80, 0x41, 0x26,
+	0x5e, 0xb4, 0xf5, 0x5b, 0x11, 0xf0, 0x70, 0xe2, 0xa5, 0xdc, 0xb8, 0x92, 0x72, 0xe3, 0xb3, 0x50,
+	0x4b, 0x46, 0xb9, 0x6a, 0xc8, 0x02, 0x1c, 0xdd, 0x37, 0x7c, 0x17, 0x59, 0x86, 0x49, 0x98, 0x87,
+	0xcf, 0xd9, 0x37, 0x02, 0xfb, 0x16, 0xd1, 0x7e, 0xa7, 0xc0, 0x5a, 0xa4, 0x23, 0x9b, 0xd5, 0x1d,
+	0x62, 0xeb, 0x2f, 0x43, 0x29, 0x96, 0xb6, 0x64, 0x5b, 0x19, 0xe3, 0x95, 0x66, 0x04, 0xf8, 0xc4,
+	0xc1, 0x62, 0xa6, 0x48, 0x8b, 0x84, 0xef, 0x9f, 0x2a, 0xb0, 0x7c, 0xc8, 0x26, 0xec, 0x0b, 0x6f,
+	0x43, 0x6f, 0xa5, 0xea, 0xe3, 0xf4, 0xe1, 0x2e, 0x4f, 0xa2, 0xb8, 0x54, 0xbe, 0x0e, 0xeb, 0x81,
+	0x64, 0x60, 0x23, 0x7d, 0x0c, 0x58, 0x93, 0xbf, 0x1e, 0x46, 0x47, 0x82, 0x2f, 0xca, 0xb0, 0x11,
+	0xfb, 0x90, 0x4c, 0x5d, 0xc7, 0x34, 0x8e, 0x66, 0x1d, 0x0a, 0x0d, 0x60, 0x53, 0xa6, 0x14, 0x18,
+	0xc7, 0x36, 0x39, 0x72, 0x43, 0x62, 0x44, 0x9e, 0xcc, 0xe3, 0xb2, 0x96, 0x5a, 0x7b, 0x8e, 0x0f,
+	0xea, 0x1b, 0x09, 0x42, 0x1f, 0x71, 0x3a, 0x22, 0x9b, 0xa1, 0x21, 0x6c, 0x0a, 0x8a, 0x31, 0x87,
+	0x04, 0x3a, 0xab, 0x86, 0x16, 0xd4, 0xd1, 0x86, 0xa0, 0x24, 0x58, 0xc8, 0x8b, 0x08, 0xd0, 0x7b,
+	0xb0, 0x2a, 0x74, 0x25, 0x05, 0x82, 0x0a, 0x23, 0xbe, 0x99, 0x22, 0x9e, 0xb4, 0x97, 0xbe, 0x42,
+	0x12, 0x63, 0x1c, 0x48, 0xd9, 0x69, 0x69, 0xf1, 0xec, 0xf4, 0x1b, 0x05, 0xd6, 0xf6, 0xed, 0xa1,
+	0x4f, 0xf3, 0x05, 0x23, 0x18, 0xed, 0xe6, 0x2b, 0xb0, 0x3c, 0xf0, 0xdd, 0x91, 0x64, 0x4a, 0xee,
+	0xb8, 0x2d, 0x0a, 0x8d, 0x4c, 0x88, 0xb6, 0xa0, 0x45, 0xdc, 0x8c, 0xb9, 0x81, 0xb8, 0x31, 0xc6,
+	0x26, 0x40, 0x8f, 0x2e, 0xd0, 0xa0, 0xc7, 0x3e, 0xd1, 0x81, 0x69, 0x30, 0xc8, 0x81, 0xfd, 0x39,
+	0xa6, 0x1b, 0xd0, 0xf2, 0x27, 0x86, 0x1f, 0x8e, 0x99, 0x23, 0xd7, 0xf5, 0xaa, 0xe5, 0x4f, 0xf4,
+	0x70, 0xac, 0xfd, 0x5d, 0x81, 0x33, 0x91, 0xc8, 0x74, 0x79, 0xcc, 0x2d, 0x58, 0x71, 0xf0, 0xa5,
+	0x6d, 0xa3, 0x4b, 0xd0, 0x72, 0x1d, 0xcb, 0x48, 0xd5, 0xb1, 0x4d, 0xd7, 0xb1, 0xe4, 0xfa, 0x74,
+	0x8c, 0x8f, 0xd3, 0x65, 0x6c, 0x73, 0x8c, 0x8f, 0x63, 0x94, 0x0e, 0xd4, 0x47, 0x5c, 0x81, 0x16,
+	0x8b, 0x13, 0x75, 0x3d, 0x1e, 0x53, 0xbe, 0x3c, 0xf4, 0xf3, 0x14, 0xc8, 0x07, 0xda, 0xcf, 0x4b,
+	0x39, 0xa2, 0x31, 0x8f, 0xff, 0xb2, 0x94, 0x2e, 0x69, 0xb5, 0x2c, 0x6b, 0x95, 0xa6, 0xc9, 0x5e,
+	0xec, 0x65, 0xac, 0x19, 0x26, 0x86, 0x09, 0x31, 0x78, 0x23, 0x71, 0x2a, 0xc6, 0x7a, 0xec, 0x59,
+	0x55, 0xf6, 0x45, 0x8c, 0xd0, 0x4d, 0x7a, 0x3c, 0xa0, 0x36, 0xa1, 0x5d, 0x24, 0xea, 0x72, 0x57,
+	0x0a, 0x7c, 0x36, 0x61, 0x40, 0x3d, 0x9a, 0xa4, 0x79, 0x80, 0xee, 0x61, 0xb2, 0x8f, 0x09, 0xf6,
+	0xa5, 0x3c, 0xd2, 0x85, 0x0a, 0x15, 0x57, 0x55, 0xe6, 0x06, 0x5d, 0x86, 0x87, 0x5e, 0x82, 0x12,
+	0x71, 0x17, 0xe8, 0xbd, 0x95, 0x88, 0xab, 0xfd, 0x55, 0x81, 0xe5, 0x29, 0xbf, 0xbe, 0xeb, 0xb3,
+	0xc6, 0x8e, 0x68, 0x11, 0xc7, 0xea, 0xae, 0x73, 0x40, 0x61, 0x81, 0x9d, 0xf6, 0xb8, 0x72, 0xd6,
+	0xe3, 0x16, 0xa8, 0xb3, 0x67, 0xd6, 0x57, 0x1b, 0xd0, 0x18, 0xda, 0x3d, 0xe3, 0xc8, 0x0d, 0xfd,
+	0x80, 0x69, 0x5d, 0xd1, 0xeb, 0x43, 0xbb, 0x77, 0x9f, 0x8e, 0xb5, 0x7f, 0x56, 0x64, 0x29, 0x98,
+	0xe7, 0x7c, 0x85, 0x4a, 0x43, 0x37, 0xa8, 0x99, 0xa9, 0xae, 0xa2, 0xb8, 0x97, 0x0e, 0x4d, 0x49,
+	0x8d, 0xea, 0x11, 0x36, 0xba, 0x0f, 0x8d, 0xde, 0x44, 0x38, 0xaa, 0x88, 0x6a, 0x57, 0x0b, 0xa7,
+	0x52, 0x31, 0xba, 0xb7, 0x27, 0xdc, 0x65, 0x44, 0xef, 0xb0, 0x27, 0x86, 0xe8, 0x7d, 0x80, 0xde,
+	0xc4, 0x98, 0x9e, 0x74, 0x29, 0xa9, 0x97, 0xe7, 0x91, 0x3a, 0xe0, 0xe8, 0x9c, 0x56, 0xa3, 0x17,
+	0x8d, 0xd1, 0x87, 0xd0, 0xea, 0x4d, 0x8c, 0x69, 0xc5, 0xcd, 0x7b, 0xe3, 0xdd, 0x79, 0xe4, 0xe2,
+	0x2a, 0x8a, 0x13, 0x6c, 0xf6, 0xa6, 0x90, 0x69, 0x3f, 0xba, 0xc6, 0x0f, 0x99, 0x6c, 0x40, 0x3b,
+	0x9e, 0x09, 0x81, 0xe6, 0x75, 0x3c, 0x15, 0xa9, 0xe3, 0xd9, 0x79, 0x1b, 0x96, 0x93, 0x22, 0x9c,
+	0x68, 0xf6, 0x4d, 0x58, 0x49, 0xaf, 0xf8, 0x24, 0xf3, 0xb5, 0xff, 0x28, 0xd0, 0x16, 0xcc, 0x6f,
+	0xb1, 0xaa, 0x1c, 0xbd, 0x02, 0xc8, 0x74, 0x1c, 0xf7, 0x18, 0x5b, 0x53, 0xd5, 0xf1, 0xd2, 0xb1,
+	0xa1, 0xaf, 0x8a, 0x2f, 0x31, 0xc3, 0x00, 0x5d, 0x85, 0x55, 0x0b, 0x8f, 0xed, 0x24, 0x36, 0x6f,
+	0x12, 0xad, 0xf0, 0x0f, 0x12, 0xf2, 0x65, 0x68, 0x47, 0xb4, 0x69, 0xd5, 0x12, 0x75, 0x1b, 0x5a,
+	0x02, 0x48, 0xab, 0x96, 0x80, 0x6e, 0x29, 0x41, 0x91, 0xe3, 0xf0, 0xcb, 0x90, 0x26, 0x87, 0x71,
+	0x14, 0x89, 0x8e, 0xef, 0x3a, 0x71, 0x4d, 0x1d, 0xd1, 0xd1, 0x29, 0x4c, 0xa2, 0xc3, 0x71, 0xaa,
+	0x32, 0x1d, 0x86, 0xa2, 0xfd, 0xa4, 0x04, 0xb5, 0xc8, 0x5b, 0xf2, 0x0e, 0x6c, 0xb4, 0xe9, 0x65,
+	0x7f, 0xce, 0xd5, 0x56, 0xd6, 0xd9, 0x7f, 0x0a, 0x63, 0xe7, 0x7f, 0x7e, 0xe2, 0x66, 0xff, 0xd1,
+	0x5d, 0x00, 0x93, 0x10, 0xdf, 0xee, 0x85, 0x24, 0xce, 0xeb, 0x2f, 0xa6, 0xfc, 0x4c, 0xf0, 0xe9,
+	0xde, 0x8a, 0x11, 0xb9, 0x7f, 0x49, 0x33, 0xd1, 0x75, 0xda, 0xbb, 0xa1, 0x56, 0x60, 0x71, 0xa2,
+	0xb9, 0x73, 0x3e, 0x9f, 0x06, 0xb7, 0x94, 0x2e, 0x70, 0x3b, 0xef, 0xc0, 0xa9, 0x14, 0xd1, 0x13,
+	0xb5, 0xdc, 0xb7, 0x00, 0x04, 0x5d, 0xda, 0x73, 0xcf, 0x51, 0x83, 0x76, 0x07, 0x4e, 0xd3, 0x26,
+	0xa2, 0xc0, 0x7a, 0xe6, 0x5e, 0xe4, 0x27, 0x70, 0x3e, 0xd1, 0x47, 0x4d, 0xd3, 0xfb, 0x0a, 0x1a,
+	0xa9, 0x5f, 0x28, 0xb0, 0x32, 0x65, 0x23, 0xfa, 0x02, 0x3b, 0x50, 0x17, 0x11, 0x26, 0x3a, 0x12,
+	0xad, 0xe7, 0xeb, 0x59, 0x8f, 0xf1, 0x9e, 0xa7, 0x19, 0xf9, 0x11, 0xac, 0xde, 0xc3, 0x24, 0x56,
+	0x71, 0x7c, 0x5b, 0x96, 0xf1, 0xb6, 0x93, 0x0a, 0xe7, 0xc1, 0x99, 0xb4, 0x2e, 0x1f, 0xb3, 0x9b,
+	0xc4, 0x05, 0x14, 0xa9, 0x4e, 0x4f, 0x85, 0xe2, 0x62, 0x4e, 0x0c, 0x67, 0x75, 0x95, 0xb4, 0xdf,
+	0x97, 0x60, 0x59, 0x70, 0xda, 0x13, 0x77, 0x93, 0xaf, 0x4e, 0x1b, 0x93, 0xdc, 0x0f, 0x8a, 0x74,
+	0x19, 0xa1, 0xc9, 0x8d, 0xe0, 0xd2, 0x42, 0x8d, 0xe0, 0xfb, 0x80, 0xc4, 0x5f, 0x43, 0xb2, 0x41,
+	0x79, 0x9e, 0x0d, 0x56, 0xc5, 0xa4, 0x29, 0x88, 0xc6, 0x8d, 0x88, 0x12, 0x0f, 0xe3, 0xbc, 0x38,
+	0x6a, 0x09, 0xe0, 0x21, 0x85, 0xa1, 0x3d, 0x00, 0x29, 0x94, 0x2d, 0xe5, 0x16, 0x3c, 0xb9, 0x7a,
+	0xd7, 0xa5, 0x79, 0xda, 0xaf, 0xcb, 0xb0, 0xf6, 0xd8, 0xb3, 0x4c, 0x82, 0x17, 0xb0, 0xfc, 0x0d,
+	0xa8, 0xd3, 0xf2, 0x93, 0xc1, 0x4b, 0x62, 0xe7, 0xa7, 0x73, 0xf5, 0x01, 0xa1, 0x59, 0xea, 0x09,
+	0xdd, 0xb6, 0x7a, 0x6d, 0x8c, 0x8f, 0x59, 0x6d, 0x71, 0x4d, 0x04, 0x28, 0xae, 0x8c, 0x8d, 0xcc,
+	0xa4, 0x07, 0x63, 0xf2, 0xcd, 0xeb, 0x7c, 0x0e, 0x43, 0xa4, 0x13, 0x58, 0xf4, 0xaa, 0x2c, 0x30,
+	0x81, 0x22, 0xa2, 0x83, 0x44, 0x68, 0xe3, 0xda, 0x78, 0x2d, 0xa5, 0x8d, 0x3c, 0x39, 0x17, 0x8c,
+	0x73, 0xd5, 0xff, 0x5f, 0x9c, 0xfb, 0xf7, 0x34, 0xd5, 0xf1, 0x23, 0xd2, 0xc2, 0x21, 0xff, 0x9a,
+	0x14, 0xf2, 0x17, 0x52, 0xda, 0xc3, 0x9c, 0x7c, 0xf0, 0x72, 0xbe, 0x8c, 0x7c, 0x29, 0xb3, 0xb4,
+	0xf5, 0xbc, 0x72, 0xff, 0x00, 0xce, 0x70, 0x26, 0xe9, 0x78, 0xfb, 0x7a, 0x26, 0x0e, 0x9e, 0x9f,
+	0xb5, 0x46, 0x29, 0x1a, 0x4a, 0xe7, 0x8e, 0x52, 0xe2, 0x34, 0xf7, 0x26, 0x5c, 0xd4, 0x71, 0xdf,
+	0x74, 0xfa, 0xa1, 0x33, 0xf5, 0x88, 0x80, 0xef, 0x0e, 0xc1, 0x55, 0x9a, 0xab, 0x24, 0xe6, 0x7e,
+	0x17, 0x56, 0xe5, 0xdd, 0xb4, 0x7b, 0x84, 0xfb, 0x4f, 0x73, 0x4d, 0xd4, 0x81, 0x3a, 0xaf, 0x3c,
+	0xb1, 0x15, 0x5d, 0x0a, 0x46, 0x63, 0x71, 0xfb, 0x11, 0x9a, 0x8e, 0x88, 0x5d, 0x62, 0xa4, 0x85,
+	0x80, 0x12, 0x5b, 0x95, 0x57, 0xd3, 0x45, 0x6b, 0x41, 0x77, 0xa1, 0x6d, 0xd9, 0x41, 0xdf, 0xc7,
+	0x9e, 0x39, 0xee, 0xdb, 0x71, 0xa4, 0xda, 0xca, 0xd7, 0xcf, 0x74, 0xbd, 0x7a, 0x72, 0x9a, 0xd6,
+	0x85, 0xd3, 0x07, 0x93, 0x71, 0x3f, 0xad, 0xf9, 0x42, 0x1d, 0xfc, 0x49, 0x89, 0x95, 0x40, 0xe7,
+	0xcd, 0x5b, 0x26, 0xed, 0x86, 0xf2, 0x7e, 0x94, 0x28, 0xb9, 0xa2, 0x21, 0xfd, 0x12, 0xb2, 0x5d,
+	0x69, 0x45, 0x37, 0x3a, 0x62, 0x88, 0xd8, 0x33, 0x13, 0x07, 0x13, 0x16, 0x04, 0xd8, 0x17, 0x31,
+	0x7c, 0xb6, 0xc6, 0xc2, 0x2e, 0x9c, 0x9b, 0x66, 0xb7, 0xbb, 0xae, 0x8f, 0xfb, 0x66, 0x40, 0x66,
+	0xc5, 0x3a, 0x04, 0x15, 0xcb, 0x9c, 0x44, 0x69, 0x87, 0xfd, 0xd7, 0x7e, 0x28, 0xf5, 0x2e, 0x99,
+	0x32, 0xef, 0xf9, 0xee, 0x31, 0x39, 0x5a, 0x24, 0x91, 0xcd, 0xb8, 0x21, 0xa6, 0xc7, 0xec, 0x21,
+	0x23, 0x64, 0xd0, 0xa7, 0x21, 0x96, 0x19, 0x25, 0xb4, 0x16, 0x87, 0x3e, 0xc2, 0xfe, 0x9e, 0x39,
+	0xd1, 0xfe, 0x56, 0x82, 0x53, 0xa9, 0xf5, 0x3f, 0x57, 0x31, 0x48, 0xaf, 0xea, 0xcc, 0x91, 0xe7,
+	0x4c, 0xcf, 0xde, 0x62, 0x98, 0xb3, 0x9e, 0xa5, 0xec, 0x7a, 0xd0, 0x4d, 0x68, 0x51, 0xa5, 0x18,
+	0xc4, 0x35, 0x06, 0xa1, 0xe3, 0xc4, 0x21, 0x32, 0x1d, 0x75, 0xf6, 0xdc, 0xb0, 0xe7, 0x60, 0x1e,
+	0x76, 0x80, 0xce, 0x38, 0x74, 0xef, 0x86, 0x8e, 0x83, 0x6e, 0x40, 0x83, 0xce, 0x33, 0xa8, 0xb9,
+	0xd5, 0x9a, 0xa8, 0x25, 0x8a, 0x4f, 0x7e, 0x75, 0x8a, 0xbc, 0x67, 0x12, 0x8c, 0x76, 0x13, 0x89,
+	0xaf, 0x9e, 0xdb, 0xfa, 0xca, 0x33, 0x93, 0x9c, 0xf7, 0x76, 0xfe, 0xdc, 0x82, 0xda, 0x93, 0x38,
+	0x71, 0xb7, 0xe4, 0x77, 0x0f, 0x28, 0xdd, 0xaf, 0xcb, 0x79, 0x14, 0xd1, 0x59, 0x4b, 0x3f, 0x5d,
+	0xa2, 0x77, 0xb0, 0xe8, 0x10, 0x50, 0xf6, 0x1d, 0x05, 0xda, 0x4e, 0xe1, 0x16, 0x3e, 0xb5, 0x28,
+	0xa0, 0xfa, 0x10, 0x9a, 0xd2, 0xc5, 0x2e, 0xba, 0x94, 0x42, 0xca, 0x5e, 0xfa, 0x76, 0xf2, 0x7b,
+	0xe7, 0x0c, 0x11, 0x1d, 0x40, 0x3b, 0x71, 0x57, 0x86, 0x2e, 0xcf, 0xb8, 0x13, 0x8b, 0x42, 0x41,
+	0x67, 0xb3, 0x68, 0x93, 0xf1, 0x5a, 0xf5, 0x6d, 0x68, 0xdc, 0xc3, 0x62, 0x0e, 0x2a, 0x7c, 0xcb,
+	0xd0, 0xc9, 0x2f, 0xa1, 0xa8, 0x01, 0xe4, 0x77, 0x18, 0x19, 0x03, 0xe4, 0x3c, 0xd2, 0x28, 0x50,
+	0x95, 0x0e, 0xab, 0x99, 0x67, 0x1d, 0xe8, 0x6b, 0x29, 0xd4, 0xa2, 0x87, 0x1f, 0x05, 0x34, 0xef,
+	0x41, 0x53, 0x7a, 0xc0, 0x91, 0x51, 0x7f, 0xf6, 0x71, 0x47, 0x91, 0x98, 0x3d, 0x58, 0xcb, 0x7b,
+	0xa0, 0x81, 0x5e, 0x4a, 0xa1, 0xcf, 0x78, 0xc5, 0xd1, 0xb9, 0x90, 0x4b, 0x7a, 0x7a, 0x68, 0xf8,
+	0x0e, 0x9c, 0x4a, 0x3d, 0xc9, 0x40, 0x2f, 0xe4, 0x90, 0xcf, 0x3e, 0xd9, 0x98, 0x4b, 0xf9, 0x09,
+	0x2c, 0x27, 0x9f, 0x68, 0xa0, 0x2b, 0x39, 0x84, 0x33, 0x2f, 0x38, 0xe6, 0xd2, 0xbd, 0x0b, 0xcb,
+	0xb1, 0xeb, 0xf0, 0xab, 0xa0, 0x62, 0xff, 0x99, 0x71, 0x27, 0x84, 0x46, 0x70, 0xb6, 0xe0, 0x76,
+	0x0a, 0xbd, 0x92, 0x9a, 0x36, 0xfb, 0x16, 0xab, 0x53, 0x18, 0x4c, 0x64, 0x9a, 0x37, 0xa1, 0xc5,
+	0xdf, 0x6e, 0xcc, 0x75, 0xfa, 0x7c, 0xaf, 0xfa, 0x1e, 0xac, 0xe7, 0xbf, 0xfd, 0x40, 0xe9, 0x0a,
+	0x6c, 0xe6, 0x13, 0x91, 0x02, 0xea, 0xef, 0xc2, 0x2a, 0x9f, 0x26, 0x3b, 0x42, 0x2e, 0x6a, 0x01,
+	0x81, 0x01, 0xac, 0xa4, 0x2f, 0xd6, 0xd0, 0x8b, 0x05, 0xf7, 0x0b, 0xa9, 0x9b, 0xb7, 0xce, 0x4b,
+	0xf9, 0xf7, 0x1d, 0xb9, 0x97, 0x2a, 0x1f, 0x43, 0x3b, 0xd1, 0xef, 0xcf, 0x44, 0xa3, 0xbc, 0xdb,
+	0x80, 0xce, 0xdc, 0xc6, 0x2e, 0xa3, 0xfd, 0x6d, 0x68, 0x4a, 0xfd, 0xdc, 0xcc, 0xc6, 0xcd, 0xf6,
+	0x7a, 0x3b, 0x9b, 0x33, 0x9b, 0x6e, 0x3b, 0xff, 0xa8, 0x42, 0x3d, 0xaa, 0x91, 0xd0, 0x3b, 0xd0,
+	0xe6, 0x21, 0x5c, 0x40, 0x50, 0xc1, 0x89, 0xb2, 0x40, 0xc1, 0x8f, 0xa3, 0x77, 0x00, 0x31, 0xc1,
+	0x2b, 0xb9, 0x21, 0x36, 0x55, 0x93, 0xcd, 0x0b, 0xc4, 0xfb, 0x00, 0xd3, 0x3a, 0x07, 0x6d, 0x65,
+	0x45, 0x4e, 0x1e, 0x7f, 0x32, 0xe4, 0x52, 0xc7, 0xe6, 0x03, 0x68, 0xc9, 0x2d, 0x95, 0x4c, 0x64,
+	0xce, 0xe9, 0xb7, 0x74, 0x2e, 0xe6, 0x93, 0x9c, 0xee, 0x78, 0xcc, 0x1f, 0x7b, 0x65, 0x1a, 0x2c,
+	0xe8, 0xea, 0xac, 0x40, 0x78, 0x62, 0x36, 0xef, 0x41, 0x3b, 0x71, 0xe2, 0xcb, 0xb8, 0x56, 0xde,
+	0x79, 0xb0, 0xc0, 0x5a, 0xdf, 0x82, 0x36, 0xdf, 0x4f, 0x11, 0xad, 0x73, 0xf9, 0xdc, 0x8b, 0xf7,
+	0xfb, 0x08, 0xd4, 0xa2, 0x23, 0x07, 0xea, 0x66, 0x37, 0xd6, 0xac, 0xb3, 0x49, 0xe7, 0xd2, 0x8c,
+	0xfa, 0x5e, 0xf8, 0xfe, 0x21, 0xb4, 0xe4, 0x8a, 0x3e, 0x63, 0xb8, 0x9c, 0x72, 0xbf, 0x53, 0x70,
+	0x6c, 0x90, 0x2a, 0xfc, 0xef, 0xb3, 0x1b, 0x92, 0x74, 0x15, 0xba, 0x5d, 0xe8, 0x65, 0xa9, 0x42,
+	0x3b, 0x93, 0x0b, 0x52, 0x68, 0xb7, 0x1b, 0x1f, 0xd7, 0x86, 0xbe, 0xd7, 0x37, 0x3d, 0xbb, 0x57,
+	0x65, 0x35, 0xe0, 0x6b, 0xff, 0x1b, 0x00, 0x6d, 0x38, 0x33, 0xa9, 0x31, 0x2f, 0x00, 0x00,
+}
@@ -0,0 +1,145 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/router/middleware"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/containerum/utils/httputil"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// adminMethods are available only to admins like REST routes guarded by RequireAdminRole
+var adminMethods = map[string]bool{
+	"/volumemanager.Volumes/AdminResizeVolume":         true,
+	"/volumemanager.Volumes/ListAllVolumes":            true,
+	"/volumemanager.Volumes/GetVolumeViews":            true,
+	"/volumemanager.Volumes/GetNamespaceVolumeViews":   true,
+	"/volumemanager.Volumes/ReconcileBilling":          true,
+	"/volumemanager.Volumes/MigrateTariff":             true,
+	"/volumemanager.Volumes/GetMetering":               true,
+	"/volumemanager.Storages/CreateStorage":            true,
+	"/volumemanager.Storages/GetStorage":               true,
+	"/volumemanager.Storages/ListStorages":             true,
+	"/volumemanager.Storages/UpdateStorage":            true,
+	"/volumemanager.Storages/DeleteStorage":            true,
+	"/volumemanager.Storages/RecalculateStoragesUsage": true,
+	"/volumemanager.Storages/SyncStorages":             true,
+	"/volumemanager.Storages/GetStorageForecast":       true,
+}
+
+// headerTags are validation tags of headers, same as in REST API
+var headerTags = []struct {
+	header, tag string
+}{
+	{httputil.UserIDXHeader, "uuid"},
+	{httputil.UserRoleXHeader, "eq=admin|eq=user"},
+}
+
+type userNamespacesKey struct{}
+
+// requestContext fills context from request metadata like REST middlewares do from headers.
+// Metadata keys are header names in lower case. Capacities are always represented in bytes.
+func (s *service) requestContext(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	header := make(http.Header, len(md))
+	for k, values := range md {
+		for _, v := range values {
+			header.Add(k, v)
+		}
+	}
+
+	gctx := &gin.Context{Request: (&http.Request{Header: header}).WithContext(ctx)}
+	httputil.SaveHeaders(gctx)
+	httputil.PrepareContext(gctx)
+	ctx = server.WithResponseUnit(gctx.Request.Context(), model.UnitBytes)
+
+	if err := s.checkHeaders(ctx, header); err != nil {
+		return nil, err
+	}
+
+	if header.Get(httputil.UserRoleXHeader) == middleware.RoleUser {
+		nsList, err := middleware.ParseUserHeaderData(header.Get(httputil.UserNamespacesXHeader))
+		if err != nil {
+			return nil, errors.ErrRequestValidationFailed().AddDetails(fmt.Sprintf("%v: %v", httputil.UserNamespacesXHeader, err))
+		}
+		ctx = context.WithValue(ctx, userNamespacesKey{}, nsList)
+	}
+
+	return ctx, nil
+}
+
+func (s *service) checkHeaders(ctx context.Context, header http.Header) error {
+	required := []string{httputil.UserIDXHeader, httputil.UserRoleXHeader}
+	if header.Get(httputil.UserRoleXHeader) == middleware.RoleUser {
+		required = append(required, httputil.UserNamespacesXHeader)
+	}
+	notProvided := errors.ErrRequiredHeadersNotProvided()
+	for _, h := range required {
+		if header.Get(h) == "" {
+			notProvided.AddDetailF("required header %s was not provided", h)
+		}
+	}
+	if len(notProvided.Details) > 0 {
+		return notProvided
+	}
+
+	invalid := errors.ErrRequestValidationFailed()
+	t, _ := s.tv.FindTranslator(httputil.GetAcceptedLanguages(ctx)...)
+	for _, v := range headerTags {
+		if err := s.tv.VarCtx(ctx, header.Get(v.header), v.tag); err != nil {
+			for _, fieldErr := range err.(validator.ValidationErrors) {
+				invalid.AddDetailF("Header %s: %s", v.header, fieldErr.Translate(t))
+			}
+		}
+	}
+	if len(invalid.Details) > 0 {
+		return invalid
+	}
+	return nil
+}
+
+// namespaceAccess checks access of user to namespace like REST access middlewares. Admins can access any namespace.
+func namespaceAccess(ctx context.Context, nsID string, check func(middleware.UserHeaderDataMap, string) error) error {
+	if nsList, isUser := ctx.Value(userNamespacesKey{}).(middleware.UserHeaderDataMap); isUser {
+		return check(nsList, nsID)
+	}
+	return nil
+}
+
+func maskForNonAdmin(ctx context.Context, m httputil.Masker) {
+	if !server.IsAdminRole(ctx) {
+		m.Mask()
+	}
+}
+
+// validate checks request with "binding" tags like gin does for REST requests
+func (s *service) validate(ctx context.Context, req interface{}) error {
+	if err := s.tv.StructCtx(ctx, req); err != nil {
+		return s.tv.ValidationError(ctx, err)
+	}
+	return nil
+}
+
+// pageRequest converts page request with the same defaults as REST "page", "per_page" and "cursor" parameters
+func pageRequest(page *grpcapi.PageRequest) (model.PageRequest, error) {
+	ret := model.PageRequest{
+		Page:    int(page.GetPage()),
+		PerPage: int(page.GetPerPage()),
+		Cursor:  page.GetCursor(),
+	}
+	if ret.PerPage == 0 && (ret.Page != 0 || ret.Cursor != "") {
+		ret.PerPage = model.DefaultPerPage
+	}
+	if err := ret.Validate(); err != nil {
+		return ret, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,352 @@
+package grpcserver
+
+import (
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	kubeClientModel "github.com/containerum/kube-client/pkg/model"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+func timestampProto(t *time.Time) *timestamp.Timestamp {
+	if t == nil {
+		return nil
+	}
+	ret, err := ptypes.TimestampProto(*t)
+	if err != nil {
+		return nil
+	}
+	return ret
+}
+
+func volumeResponse(vol model.VolumeResponse) *grpcapi.Volume {
+	ret := &grpcapi.Volume{
+		Label:       vol.Name,
+		TariffId:    vol.TariffID,
+		Capacity:    int64(vol.Capacity),
+		StorageName: vol.StorageName,
+		AccessMode:  string(vol.AccessMode),
+		Status:      vol.Status,
+		Cluster:     vol.Cluster,
+		Metadata:    vol.Metadata,
+	}
+	if createTime, err := time.Parse(time.RFC3339, vol.CreatedAt); err == nil {
+		ret.CreateTime = timestampProto(&createTime)
+	}
+	return ret
+}
+
+func volumesResponse(vols model.VolumesResponse) *grpcapi.VolumesResponse {
+	ret := &grpcapi.VolumesResponse{
+		Volumes:    make([]*grpcapi.Volume, len(vols.Volumes)),
+		Pagination: paginationResponse(vols.Pagination),
+	}
+	for i := range vols.Volumes {
+		ret.Volumes[i] = volumeResponse(vols.Volumes[i])
+	}
+	return ret
+}
+
+// storageVolume converts volume from page of storage volumes
+func storageVolume(vol *model.Volume) *grpcapi.Volume {
+	ret := &grpcapi.Volume{
+		Label:       vol.Label,
+		CreateTime:  timestampProto(vol.CreateTime),
+		Capacity:    int64(vol.Capacity),
+		StorageName: vol.StorageName,
+		AccessMode:  string(vol.AccessMode),
+		Status:      string(vol.Status),
+		Cluster:     vol.Cluster,
+		Metadata:    vol.Metadata,
+	}
+	if vol.TariffID != nil {
+		ret.TariffId = *vol.TariffID
+	}
+	return ret
+}
+
+func paginationResponse(pagination model.Pagination) *grpcapi.Pagination {
+	return &grpcapi.Pagination{
+		Total:      int32(pagination.Total),
+		Page:       int32(pagination.Page),
+		PerPage:    int32(pagination.PerPage),
+		NextCursor: pagination.NextCursor,
+	}
+}
+
+func storageAccessResponse(access model.StorageAccess) *grpcapi.StorageAccess {
+	return &grpcapi.StorageAccess{
+		AllowedNamespaces: access.AllowedNamespaces,
+		DeniedNamespaces:  access.DeniedNamespaces,
+		AllowedUsers:      access.AllowedUsers,
+		DeniedUsers:       access.DeniedUsers,
+		AllowedRoles:      access.AllowedRoles,
+		DeniedRoles:       access.DeniedRoles,
+	}
+}
+
+func storageAccessRequest(access *grpcapi.StorageAccess) model.StorageAccess {
+	return model.StorageAccess{
+		AllowedNamespaces: access.GetAllowedNamespaces(),
+		DeniedNamespaces:  access.GetDeniedNamespaces(),
+		AllowedUsers:      access.GetAllowedUsers(),
+		DeniedUsers:       access.GetDeniedUsers(),
+		AllowedRoles:      access.GetAllowedRoles(),
+		DeniedRoles:       access.GetDeniedRoles(),
+	}
+}
+
+func storageResponse(storage model.Storage) *grpcapi.Storage {
+	return &grpcapi.Storage{
+		Name:       storage.Name,
+		Size:       int64(storage.Size),
+		Used:       int64(storage.Used),
+		Attributes: storage.Attributes,
+		Access:     storageAccessResponse(storage.StorageAccess),
+	}
+}
+
+func storagesResponse(storages []model.Storage, pagination model.Pagination) *grpcapi.StoragesResponse {
+	ret := &grpcapi.StoragesResponse{
+		Storages:   make([]*grpcapi.Storage, len(storages)),
+		Pagination: paginationResponse(pagination),
+	}
+	for i := range storages {
+		ret.Storages[i] = storageResponse(storages[i])
+	}
+	return ret
+}
+
+func storageDetailsResponse(details model.StorageDetails) *grpcapi.StorageDetails {
+	ret := &grpcapi.StorageDetails{
+		Storage:           storageResponse(details.Storage),
+		Volumes:           make([]*grpcapi.Volume, len(details.Volumes)),
+		VolumesPagination: paginationResponse(details.VolumesPagination),
+		VolumesTotal:      int32(details.VolumesTotal),
+		Namespaces:        make([]*grpcapi.NamespaceStorageUsage, len(details.Namespaces)),
+	}
+	for i, vol := range details.Volumes {
+		ret.Volumes[i] = storageVolume(vol)
+	}
+	for i, usage := range details.Namespaces {
+		ret.Namespaces[i] = &grpcapi.NamespaceStorageUsage{
+			NamespaceId: usage.NamespaceID,
+			Volumes:     int32(usage.Volumes),
+			Capacity:    int64(usage.Capacity),
+		}
+	}
+	return ret
+}
+
+func volumeQuoteResponse(quote model.VolumeQuote) *grpcapi.VolumeQuote {
+	return &grpcapi.VolumeQuote{
+		Action:          string(quote.Action),
+		Feasible:        quote.Feasible,
+		Reasons:         quote.Reasons,
+		Capacity:        int64(quote.Capacity),
+		CurrentCapacity: int64(quote.CurrentCapacity),
+		StorageName:     quote.StorageName,
+		TariffId:        quote.TariffID,
+		TariffLabel:     quote.TariffLabel,
+		Price:           quote.Price,
+		StorageHeadroom: int64(quote.StorageHeadroom),
+		QuotaLimit:      int64(quote.QuotaLimit),
+		QuotaUsed:       int64(quote.QuotaUsed),
+		QuotaHeadroom:   int64(quote.QuotaHeadroom),
+	}
+}
+
+func importResults(results []kubeClientModel.ImportResult) []*grpcapi.ImportResult {
+	ret := make([]*grpcapi.ImportResult, len(results))
+	for i, res := range results {
+		ret[i] = &grpcapi.ImportResult{
+			Name:      res.Name,
+			Namespace: res.Namespace,
+			Message:   res.Message,
+		}
+	}
+	return ret
+}
+
+func importResponse(resp kubeClientModel.ImportResponse) *grpcapi.ImportResponse {
+	return &grpcapi.ImportResponse{
+		Imported: importResults(resp.Imported),
+		Failed:   importResults(resp.Failed),
+	}
+}
+
+// kubeVolumeResponse converts volume reported by kube backend, capacity is in bytes even if backend reports GiB only
+func kubeVolumeResponse(vol *model.KubeVolume) *grpcapi.KubeVolume {
+	ret := &grpcapi.KubeVolume{
+		Name:        vol.Name,
+		Namespace:   vol.Namespace,
+		BoundTime:   timestampProto(vol.BoundTime),
+		TariffId:    vol.TariffID,
+		Capacity:    vol.CapacityBytes,
+		StorageName: vol.StorageName,
+		AccessMode:  string(vol.AccessMode),
+		Status:      vol.Status,
+		Reason:      vol.Reason,
+		Cluster:     vol.Cluster,
+	}
+	if ret.Capacity == 0 {
+		ret.Capacity = int64(model.GiBytes(int(vol.Capacity)))
+	}
+	if createTime, err := time.Parse(time.RFC3339, vol.CreatedAt); err == nil {
+		ret.CreateTime = timestampProto(&createTime)
+	}
+	return ret
+}
+
+func volumeViewsResponse(views model.VolumeViews) *grpcapi.VolumeViews {
+	ret := &grpcapi.VolumeViews{
+		Label:      views.Label,
+		KubeError:  views.KubeError,
+		Mismatches: views.Mismatches,
+	}
+	if views.DB != nil {
+		ret.Db = storageVolume(views.DB)
+	}
+	if views.Kube != nil {
+		ret.Kube = kubeVolumeResponse(views.Kube)
+	}
+	return ret
+}
+
+func reconciliationVolume(vol model.ReconciliationVolume) *grpcapi.ReconciliationVolume {
+	return &grpcapi.ReconciliationVolume{
+		Id:          vol.ID,
+		NamespaceId: vol.NamespaceID,
+		Label:       vol.Label,
+		TariffId:    vol.TariffID,
+		OwnerUserId: vol.OwnerUserID,
+	}
+}
+
+func billingReconciliationResponse(report model.BillingReconciliationReport) *grpcapi.BillingReconciliationReport {
+	ret := &grpcapi.BillingReconciliationReport{
+		Fix:                         report.Fix,
+		SubscriptionsWithoutVolumes: make([]*grpcapi.BillingSubscription, len(report.SubscriptionsWithoutVolumes)),
+		VolumesWithoutSubscriptions: make([]*grpcapi.ReconciliationVolume, len(report.VolumesWithoutSubscriptions)),
+		TariffMismatches:            make([]*grpcapi.TariffMismatch, len(report.TariffMismatches)),
+		Failed:                      importResults(report.Failed),
+	}
+	for i, sub := range report.SubscriptionsWithoutVolumes {
+		ret.SubscriptionsWithoutVolumes[i] = &grpcapi.BillingSubscription{
+			ResourceId:    sub.ResourceID,
+			ResourceLabel: sub.ResourceLabel,
+			ResourceType:  string(sub.ResourceType),
+			TariffId:      sub.TariffID,
+			UserId:        sub.UserID,
+			CreatedAt:     timestampProto(sub.CreatedAt),
+		}
+	}
+	for i, vol := range report.VolumesWithoutSubscriptions {
+		ret.VolumesWithoutSubscriptions[i] = reconciliationVolume(vol)
+	}
+	for i, mismatch := range report.TariffMismatches {
+		ret.TariffMismatches[i] = &grpcapi.TariffMismatch{
+			Volume:               reconciliationVolume(mismatch.ReconciliationVolume),
+			SubscriptionTariffId: mismatch.SubscriptionTariffID,
+		}
+	}
+	return ret
+}
+
+func tariffMigrationResponse(report model.TariffMigrationReport) *grpcapi.TariffMigrationReport {
+	ret := &grpcapi.TariffMigrationReport{
+		FromTariffId: report.FromTariffID,
+		ToTariffId:   report.ToTariffID,
+		DryRun:       report.DryRun,
+		Batches:      int32(report.Batches),
+		Migrated:     int32(report.Migrated),
+		Failed:       int32(report.Failed),
+		Results:      make([]*grpcapi.TariffMigrationResult, len(report.Results)),
+	}
+	for i, res := range report.Results {
+		ret.Results[i] = &grpcapi.TariffMigrationResult{
+			Id:          res.ID,
+			NamespaceId: res.NamespaceID,
+			Label:       res.Label,
+			OldCapacity: int64(res.OldCapacity),
+			NewCapacity: int64(res.NewCapacity),
+			Migrated:    res.Migrated,
+			Error:       res.Error,
+		}
+	}
+	return ret
+}
+
+func meteringResponse(report model.MeteringReport) *grpcapi.MeteringReport {
+	ret := &grpcapi.MeteringReport{
+		From:        timestampProto(&report.From),
+		To:          timestampProto(&report.To),
+		Records:     make([]*grpcapi.MeteringRecord, len(report.Records)),
+		ByTariff:    report.ByTariff,
+		ByStorage:   report.ByStorage,
+		ByNamespace: report.ByNamespace,
+		Total:       report.Total,
+	}
+	for i, rec := range report.Records {
+		ret.Records[i] = &grpcapi.MeteringRecord{
+			VolumeId:    rec.VolumeID,
+			Label:       rec.Label,
+			NamespaceId: rec.NamespaceID,
+			StorageName: rec.StorageName,
+			TariffId:    rec.TariffID,
+			GibHours:    rec.GiBHours,
+		}
+	}
+	return ret
+}
+
+func storageUsageResponse(report model.StorageUsageReport) *grpcapi.StorageUsageReport {
+	ret := &grpcapi.StorageUsageReport{
+		DryRun:        report.DryRun,
+		Discrepancies: make([]*grpcapi.StorageUsageCheck, len(report.Discrepancies)),
+	}
+	for i, check := range report.Discrepancies {
+		ret.Discrepancies[i] = &grpcapi.StorageUsageCheck{
+			Name:     check.Name,
+			Recorded: int64(check.Recorded),
+			Actual:   int64(check.Actual),
+		}
+	}
+	return ret
+}
+
+func storageSyncResponse(report model.StorageSyncReport) *grpcapi.StorageSyncReport {
+	return &grpcapi.StorageSyncReport{
+		DryRun:  report.DryRun,
+		Created: report.Created,
+		Updated: report.Updated,
+		Deleted: report.Deleted,
+		Failed:  importResults(report.Failed),
+	}
+}
+
+func storageForecastResponse(forecast model.StorageForecast) *grpcapi.StorageForecast {
+	ret := &grpcapi.StorageForecast{
+		Name:         forecast.Name,
+		Size:         int64(forecast.Size),
+		Used:         int64(forecast.Used),
+		Samples:      int32(forecast.Samples),
+		GrowthPerDay: int64(forecast.GrowthPerDay),
+		FullDate:     timestampProto(forecast.FullDate),
+		Namespaces:   make([]*grpcapi.NamespaceUsageGrowth, len(forecast.Namespaces)),
+	}
+	if forecast.DaysToFull != nil {
+		ret.DaysToFull = &wrappers.DoubleValue{Value: *forecast.DaysToFull}
+	}
+	for i, growth := range forecast.Namespaces {
+		ret.Namespaces[i] = &grpcapi.NamespaceUsageGrowth{
+			NamespaceId:  growth.NamespaceID,
+			Capacity:     int64(growth.Capacity),
+			GrowthPerDay: int64(growth.GrowthPerDay),
+		}
+	}
+	return ret
+}
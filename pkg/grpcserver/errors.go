@@ -0,0 +1,50 @@
+package grpcserver
+
+import (
+	"net/http"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"github.com/containerum/cherry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// statusCodes maps HTTP statuses of cherry errors to gRPC codes, other statuses are reported as Internal
+var statusCodes = map[int]codes.Code{
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusInsufficientStorage: codes.ResourceExhausted,
+}
+
+// statusError converts error to gRPC status error with cherry error in details.
+// Errors which are not cherry errors are reported as ErrInternal like in REST API.
+func statusError(err error) error {
+	cherryErr, ok := err.(*cherry.Err)
+	if !ok {
+		cherryErr = errors.ErrInternal().AddDetailsErr(err)
+	}
+
+	code, ok := statusCodes[cherryErr.StatusHTTP]
+	if !ok {
+		code = codes.Internal
+	}
+
+	st := status.New(code, cherryErr.Message)
+	if withDetails, detailsErr := st.WithDetails(&grpcapi.Error{
+		IdKind:     uint64(cherryErr.ID.Kind),
+		IdService:  string(cherryErr.ID.SID),
+		Message:    cherryErr.Message,
+		Details:    cherryErr.Details,
+		StatusHttp: int32(cherryErr.StatusHTTP),
+		Fields:     cherryErr.Fields,
+	}); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
+}
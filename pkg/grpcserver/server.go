@@ -0,0 +1,61 @@
+// Package grpcserver serves volume and storage actions over gRPC.
+// Request context, validation and access rules are the same as in REST API (see pkg/router).
+package grpcserver
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"git.containerum.net/ch/volume-manager/pkg/router"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+// service implements both Volumes and Storages gRPC services
+type service struct {
+	volumes  server.VolumeActions
+	storages server.StorageActions
+	tv       *router.TranslateValidate
+	log      *logrus.Entry
+}
+
+// NewServer returns gRPC server with Volumes and Storages services registered.
+// Errors returned by actions are converted to gRPC statuses with cherry error in details.
+func NewServer(volumes server.VolumeActions, storages server.StorageActions, tv *router.TranslateValidate) *grpc.Server {
+	s := &service{
+		volumes:  volumes,
+		storages: storages,
+		tv:       tv,
+		log:      logrus.WithField("component", "grpc"),
+	}
+
+	ret := grpc.NewServer(grpc.UnaryInterceptor(s.intercept))
+	grpcapi.RegisterVolumesServer(ret, s)
+	grpcapi.RegisterStoragesServer(ret, s)
+	return ret
+}
+
+// intercept prepares request context from metadata, checks admin only methods and converts errors
+func (s *service) intercept(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			s.log.WithField("method", info.FullMethod).Errorf("panic: %v", r)
+			resp, err = nil, statusError(errors.ErrInternal())
+		}
+	}()
+
+	ctx, err = s.requestContext(ctx)
+	if err == nil && adminMethods[info.FullMethod] && !server.IsAdminRole(ctx) {
+		err = errors.ErrAdminRequired().AddDetails("only admin can do this")
+	}
+	if err == nil {
+		resp, err = handler(ctx, req)
+	}
+	if err != nil {
+		s.log.WithError(err).WithField("method", info.FullMethod).Debugf("request failed")
+		return nil, statusError(err)
+	}
+	return resp, nil
+}
@@ -0,0 +1,219 @@
+package grpcserver
+
+import (
+	"context"
+	"encoding/base64"
+	"net"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/router"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"git.containerum.net/ch/volume-manager/pkg/utils/validation"
+	kubeModel "github.com/containerum/kube-client/pkg/model"
+	"github.com/containerum/utils/httputil"
+	"github.com/go-playground/locales/en"
+	"github.com/go-playground/universal-translator"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testUserID      = "4ae9a5c4-0a1d-4b53-9b2d-7d0d0d1c3a11"
+	testNamespaceID = "ns-1"
+)
+
+type fakeVolumes struct {
+	server.VolumeActions
+	ctx      context.Context
+	err      error
+	imported []kubeModel.Volume
+}
+
+func (f *fakeVolumes) GetVolume(ctx context.Context, nsID, label string) (model.VolumeResponse, error) {
+	f.ctx = ctx
+	if f.err != nil {
+		return model.VolumeResponse{}, f.err
+	}
+	now := time.Now()
+	vol := model.Volume{
+		Resource: model.Resource{Label: label, CreateTime: &now},
+		Capacity: 5 * model.GiB,
+	}
+	return model.VolumeResponse{Volume: vol.ToKube(), Capacity: vol.Capacity.In(server.ResponseUnit(ctx))}, nil
+}
+
+func (f *fakeVolumes) GetAllVolumes(ctx context.Context, page model.PageRequest, sort []string, filters ...string) (model.VolumesResponse, error) {
+	f.ctx = ctx
+	return model.VolumesResponse{}, f.err
+}
+
+func (f *fakeVolumes) ImportVolume(ctx context.Context, nsID string, req kubeModel.Volume) error {
+	f.imported = append(f.imported, req)
+	return f.err
+}
+
+func (f *fakeVolumes) GetMetering(ctx context.Context, from, to time.Time) (model.MeteringReport, error) {
+	f.ctx = ctx
+	return model.MeteringReport{From: from, To: to}, f.err
+}
+
+func startServer(t *testing.T, volumes server.VolumeActions) (client grpcapi.VolumesClient, stop func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	translate := ut.New(en.New(), en.New())
+	srv := NewServer(volumes, nil, &router.TranslateValidate{UniversalTranslator: translate, Validate: validation.StandardPermissionsValidator(translate)})
+	go srv.Serve(listener)
+
+	conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		srv.Stop()
+		t.Fatal(err)
+	}
+	return grpcapi.NewVolumesClient(conn), func() {
+		conn.Close()
+		srv.Stop()
+	}
+}
+
+func userContext(access kubeModel.AccessLevel) context.Context {
+	namespaces := `[{"id":"` + testNamespaceID + `","label":"ns","access":"` + string(access) + `"}]`
+	return metadata.NewOutgoingContext(context.Background(), metadata.Pairs(
+		"x-user-id", testUserID,
+		"x-user-role", "user",
+		"x-user-namespace", base64.StdEncoding.EncodeToString([]byte(namespaces)),
+		"x-request-id", "request-1",
+	))
+}
+
+func statusDetail(t *testing.T, err error, code codes.Code) *grpcapi.Error {
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("expected gRPC status, got %v", err)
+	}
+	if st.Code() != code {
+		t.Fatalf("expected code %v, got %v (%s)", code, st.Code(), st.Message())
+	}
+	for _, detail := range st.Details() {
+		if cherryErr, ok := detail.(*grpcapi.Error); ok {
+			return cherryErr
+		}
+	}
+	t.Fatalf("expected cherry error in status details, got %v", st.Details())
+	return nil
+}
+
+func TestMetadataContext(t *testing.T) {
+	volumes := &fakeVolumes{}
+	client, stop := startServer(t, volumes)
+	defer stop()
+
+	vol, err := client.GetVolume(userContext(kubeModel.Read), &grpcapi.VolumeRef{NamespaceId: testNamespaceID, Label: "vol"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vol.Label != "vol" || vol.Capacity != int64(5*model.GiB) {
+		t.Errorf("expected volume vol with capacity in bytes, got %+v", vol)
+	}
+
+	ctx := volumes.ctx
+	if userID := httputil.MustGetUserID(ctx); userID != testUserID {
+		t.Errorf("expected user id %s in context, got %s", testUserID, userID)
+	}
+	if role := httputil.MustGetUserRole(ctx); role != "user" {
+		t.Errorf("expected user role in context, got %s", role)
+	}
+	if requestID := httputil.MustGetRequestID(ctx); requestID != "request-1" {
+		t.Errorf("expected request id in context, got %s", requestID)
+	}
+	if headers := httputil.RequestHeaders(ctx); headers.Get(httputil.UserIDXHeader) != testUserID {
+		t.Errorf("expected headers saved in context for service clients, got %v", headers)
+	}
+}
+
+func TestStatusErrors(t *testing.T) {
+	volumes := &fakeVolumes{err: errors.ErrResourceNotExists().AddDetails("volume vol not exists")}
+	client, stop := startServer(t, volumes)
+	defer stop()
+
+	_, err := client.GetVolume(userContext(kubeModel.Read), &grpcapi.VolumeRef{NamespaceId: testNamespaceID, Label: "vol"})
+	detail := statusDetail(t, err, codes.NotFound)
+	expected := errors.ErrResourceNotExists()
+	if detail.IdKind != uint64(expected.ID.Kind) || detail.IdService != string(expected.ID.SID) ||
+		detail.StatusHttp != int32(expected.StatusHTTP) || len(detail.Details) != 1 || detail.Details[0] != "volume vol not exists" {
+		t.Errorf("unexpected cherry error in details: %+v", detail)
+	}
+
+	volumes.err = context.DeadlineExceeded
+	_, err = client.GetVolume(userContext(kubeModel.Read), &grpcapi.VolumeRef{NamespaceId: testNamespaceID, Label: "vol"})
+	if detail := statusDetail(t, err, codes.Internal); detail.IdKind != uint64(errors.ErrInternal().ID.Kind) {
+		t.Errorf("expected internal error for non-cherry error, got %+v", detail)
+	}
+}
+
+func TestRequestChecks(t *testing.T) {
+	volumes := &fakeVolumes{}
+	client, stop := startServer(t, volumes)
+	defer stop()
+
+	_, err := client.GetVolume(context.Background(), &grpcapi.VolumeRef{NamespaceId: testNamespaceID, Label: "vol"})
+	if detail := statusDetail(t, err, codes.InvalidArgument); detail.IdKind != uint64(errors.ErrRequiredHeadersNotProvided().ID.Kind) {
+		t.Errorf("expected required headers error without metadata, got %+v", detail)
+	}
+
+	_, err = client.ListAllVolumes(userContext(kubeModel.Owner), &grpcapi.ListAllVolumesRequest{})
+	if detail := statusDetail(t, err, codes.PermissionDenied); detail.IdKind != uint64(errors.ErrAdminRequired().ID.Kind) {
+		t.Errorf("expected admin required error for user, got %+v", detail)
+	}
+
+	_, err = client.GetVolume(userContext(kubeModel.Read), &grpcapi.VolumeRef{NamespaceId: "other", Label: "vol"})
+	statusDetail(t, err, codes.NotFound)
+
+	_, err = client.DeleteVolume(userContext(kubeModel.Read), &grpcapi.VolumeRef{NamespaceId: testNamespaceID, Label: "vol"})
+	statusDetail(t, err, codes.InvalidArgument)
+
+	volumes.ctx = nil
+	adminCtx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs("x-user-id", testUserID, "x-user-role", "admin"))
+	if _, err = client.ListAllVolumes(adminCtx, &grpcapi.ListAllVolumesRequest{}); err != nil || volumes.ctx == nil {
+		t.Errorf("expected admin to list all volumes, got %v", err)
+	}
+
+	_, err = client.GetMetering(userContext(kubeModel.Owner), &grpcapi.GetMeteringRequest{})
+	if detail := statusDetail(t, err, codes.PermissionDenied); detail.IdKind != uint64(errors.ErrAdminRequired().ID.Kind) {
+		t.Errorf("expected admin required error for user, got %+v", detail)
+	}
+	report, err := client.GetMetering(adminCtx, &grpcapi.GetMeteringRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if from, to := report.From.GetSeconds(), report.To.GetSeconds(); to-from != int64(server.DefaultMeteringRange/time.Second) {
+		t.Errorf("expected default metering range, got %d..%d", from, to)
+	}
+}
+
+func TestImportVolumes(t *testing.T) {
+	volumes := &fakeVolumes{}
+	client, stop := startServer(t, volumes)
+	defer stop()
+
+	resp, err := client.ImportVolumes(userContext(kubeModel.Read), &grpcapi.ImportVolumesRequest{Volumes: []*grpcapi.ImportVolume{
+		{NamespaceId: testNamespaceID, Label: "whole", Capacity: int64(2 * model.GiB), StorageName: "ceph"},
+		{NamespaceId: testNamespaceID, Label: "fractional", Capacity: int64(model.GiB + 512*model.MiB), StorageName: "ceph"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Imported) != 1 || resp.Imported[0].Name != "whole" || len(resp.Failed) != 1 || resp.Failed[0].Name != "fractional" {
+		t.Errorf("expected only volume with whole GiB capacity to be imported, got %+v", resp)
+	}
+	if len(volumes.imported) != 1 || volumes.imported[0].Capacity != 2 || volumes.imported[0].Namespace != testNamespaceID {
+		t.Errorf("unexpected imported volumes %+v", volumes.imported)
+	}
+}
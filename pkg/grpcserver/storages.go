@@ -0,0 +1,164 @@
+package grpcserver
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/router/middleware"
+	kubeClientModel "github.com/containerum/kube-client/pkg/model"
+)
+
+func (s *service) CreateStorage(ctx context.Context, req *grpcapi.Storage) (*grpcapi.Empty, error) {
+	storage := model.Storage{
+		Name:          req.Name,
+		Size:          model.Quantity(req.Size),
+		Used:          model.Quantity(req.Used),
+		Attributes:    req.Attributes,
+		StorageAccess: storageAccessRequest(req.Access),
+	}
+	if err := s.validate(ctx, &storage); err != nil {
+		return nil, err
+	}
+	if err := s.storages.CreateStorage(ctx, storage); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+// ImportStorages imports storages one by one like REST API does. Storage without size gets default import size.
+func (s *service) ImportStorages(ctx context.Context, req *grpcapi.ImportStoragesRequest) (*grpcapi.ImportResponse, error) {
+	resp := kubeClientModel.ImportResponse{
+		Imported: []kubeClientModel.ImportResult{},
+		Failed:   []kubeClientModel.ImportResult{},
+	}
+	for _, storage := range req.Storages {
+		r := model.StorageImportRequest{
+			Name:       storage.Name,
+			Size:       model.Quantity(storage.Size),
+			Attributes: storage.Attributes,
+		}
+		if r.Size == 0 {
+			r.Size = model.DefaultImportStorageSize
+		}
+		if storage.Used != nil {
+			used := model.Quantity(storage.Used.Value)
+			r.Used = &used
+		}
+		updated, err := s.storages.ImportStorage(ctx, r, req.DryRun)
+		switch {
+		case err != nil:
+			s.log.WithError(err).Warnf("import storage %s failed", r.Name)
+			resp.ImportFailed(r.Name, "", err.Error())
+		case req.DryRun && updated:
+			resp.Imported = append(resp.Imported, kubeClientModel.ImportResult{Name: r.Name, Message: "storage will be updated"})
+		case req.DryRun:
+			resp.Imported = append(resp.Imported, kubeClientModel.ImportResult{Name: r.Name, Message: "storage will be created"})
+		default:
+			resp.ImportSuccessful(r.Name, "")
+		}
+	}
+	return importResponse(resp), nil
+}
+
+func (s *service) GetStorage(ctx context.Context, req *grpcapi.GetStorageRequest) (*grpcapi.StorageDetails, error) {
+	page, err := pageRequest(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := s.storages.GetStorage(ctx, req.Name, page)
+	if err != nil {
+		return nil, err
+	}
+	return storageDetailsResponse(ret), nil
+}
+
+func (s *service) ListStorages(ctx context.Context, req *grpcapi.ListStoragesRequest) (*grpcapi.StoragesResponse, error) {
+	page, err := pageRequest(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	storages, pagination, err := s.storages.GetStorages(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	return storagesResponse(storages, pagination), nil
+}
+
+func (s *service) ListNamespaceStorages(ctx context.Context, req *grpcapi.ListNamespaceStoragesRequest) (*grpcapi.StoragesResponse, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.ReadNamespaceAccess); err != nil {
+		return nil, err
+	}
+	page, err := pageRequest(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	storages, pagination, err := s.storages.GetNamespaceStorages(ctx, req.NamespaceId, page)
+	if err != nil {
+		return nil, err
+	}
+	for i := range storages {
+		maskForNonAdmin(ctx, &storages[i])
+	}
+	return storagesResponse(storages, pagination), nil
+}
+
+func (s *service) UpdateStorage(ctx context.Context, req *grpcapi.UpdateStorageRequest) (*grpcapi.Empty, error) {
+	update := model.UpdateStorageRequest{
+		Attributes: req.Attributes,
+	}
+	if req.NewName != nil {
+		update.Name = &req.NewName.Value
+	}
+	if req.Size != nil {
+		size := model.Quantity(req.Size.Value)
+		update.Size = &size
+	}
+	if req.Used != nil {
+		used := model.Quantity(req.Used.Value)
+		update.Used = &used
+	}
+	if req.Access != nil {
+		access := storageAccessRequest(req.Access)
+		update.Access = &access
+	}
+	if err := s.validate(ctx, &update); err != nil {
+		return nil, err
+	}
+	if err := s.storages.UpdateStorage(ctx, req.Name, update); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) DeleteStorage(ctx context.Context, req *grpcapi.StorageRef) (*grpcapi.Empty, error) {
+	if err := s.storages.DeleteStorage(ctx, req.Name); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) RecalculateStoragesUsage(ctx context.Context, req *grpcapi.RecalculateStoragesUsageRequest) (*grpcapi.StorageUsageReport, error) {
+	ret, err := s.storages.RecalculateStoragesUsage(ctx, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return storageUsageResponse(ret), nil
+}
+
+func (s *service) SyncStorages(ctx context.Context, req *grpcapi.SyncStoragesRequest) (*grpcapi.StorageSyncReport, error) {
+	ret, err := s.storages.SyncStorages(ctx, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	return storageSyncResponse(ret), nil
+}
+
+// GetStorageForecast uses server default history length if days are not set
+func (s *service) GetStorageForecast(ctx context.Context, req *grpcapi.GetStorageForecastRequest) (*grpcapi.StorageForecast, error) {
+	ret, err := s.storages.GetStorageForecast(ctx, req.Name, int(req.Days))
+	if err != nil {
+		return nil, err
+	}
+	return storageForecastResponse(ret), nil
+}
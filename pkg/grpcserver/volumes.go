@@ -0,0 +1,297 @@
+package grpcserver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/grpcapi"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/router/middleware"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	kubeClientModel "github.com/containerum/kube-client/pkg/model"
+	"github.com/golang/protobuf/ptypes"
+)
+
+func (s *service) CreateVolume(ctx context.Context, req *grpcapi.CreateVolumeRequest) (*grpcapi.Empty, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.WriteNamespaceAccess); err != nil {
+		return nil, err
+	}
+	create := model.VolumeCreateRequest{
+		TariffID: req.TariffId,
+		Label:    req.Label,
+		Storage:  req.Storage,
+	}
+	if err := s.validate(ctx, &create); err != nil {
+		return nil, err
+	}
+	if err := s.volumes.CreateVolume(ctx, req.NamespaceId, create); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) DirectCreateVolume(ctx context.Context, req *grpcapi.DirectCreateVolumeRequest) (*grpcapi.Empty, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.WriteNamespaceAccess); err != nil {
+		return nil, err
+	}
+	create := model.DirectVolumeCreateRequest{
+		Label:    req.Label,
+		Capacity: model.Quantity(req.Capacity),
+		Storage:  req.Storage,
+	}
+	if err := s.validate(ctx, &create); err != nil {
+		return nil, err
+	}
+	if err := s.volumes.DirectCreateVolume(ctx, req.NamespaceId, create); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) QuoteVolume(ctx context.Context, req *grpcapi.QuoteVolumeRequest) (*grpcapi.VolumeQuote, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.ReadNamespaceAccess); err != nil {
+		return nil, err
+	}
+	quote := model.VolumeQuoteRequest{
+		Action:   model.VolumeQuoteAction(req.Action),
+		TariffID: req.TariffId,
+		Label:    req.Label,
+		Storage:  req.Storage,
+	}
+	if err := s.validate(ctx, &quote); err != nil {
+		return nil, err
+	}
+	ret, err := s.volumes.QuoteVolume(ctx, req.NamespaceId, quote)
+	if err != nil {
+		return nil, err
+	}
+	return volumeQuoteResponse(ret), nil
+}
+
+// ImportVolumes imports volumes one by one like REST API does, capacity must be whole number of GiB
+func (s *service) ImportVolumes(ctx context.Context, req *grpcapi.ImportVolumesRequest) (*grpcapi.ImportResponse, error) {
+	resp := kubeClientModel.ImportResponse{
+		Imported: []kubeClientModel.ImportResult{},
+		Failed:   []kubeClientModel.ImportResult{},
+	}
+	for _, vol := range req.Volumes {
+		capacity := model.Quantity(vol.Capacity)
+		if capacity < 0 || capacity%model.GiB != 0 {
+			resp.ImportFailed(vol.Label, vol.NamespaceId, fmt.Sprintf("capacity %s is not a whole number of GiB", capacity))
+			continue
+		}
+		err := s.volumes.ImportVolume(ctx, vol.NamespaceId, kubeClientModel.Volume{
+			Name:        vol.Label,
+			Namespace:   vol.NamespaceId,
+			Capacity:    uint(capacity.GiB()),
+			StorageName: vol.StorageName,
+			Owner:       vol.OwnerUserId,
+		})
+		if err != nil {
+			s.log.WithError(err).Warnf("import volume %s/%s failed", vol.NamespaceId, vol.Label)
+			resp.ImportFailed(vol.Label, vol.NamespaceId, err.Error())
+		} else {
+			resp.ImportSuccessful(vol.Label, vol.NamespaceId)
+		}
+	}
+	return importResponse(resp), nil
+}
+
+func (s *service) GetVolume(ctx context.Context, req *grpcapi.VolumeRef) (*grpcapi.Volume, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.ReadNamespaceAccess); err != nil {
+		return nil, err
+	}
+	ret, err := s.volumes.GetVolume(ctx, req.NamespaceId, req.Label)
+	if err != nil {
+		return nil, err
+	}
+	maskForNonAdmin(ctx, &ret)
+	return volumeResponse(ret), nil
+}
+
+func (s *service) ResizeVolume(ctx context.Context, req *grpcapi.ResizeVolumeRequest) (*grpcapi.Empty, error) {
+	nsID, label := req.GetVolume().GetNamespaceId(), req.GetVolume().GetLabel()
+	if err := namespaceAccess(ctx, nsID, middleware.WriteNamespaceAccess); err != nil {
+		return nil, err
+	}
+	resize := model.VolumeResizeRequest{TariffID: req.TariffId}
+	if err := s.validate(ctx, &resize); err != nil {
+		return nil, err
+	}
+	if err := s.volumes.ResizeVolume(ctx, nsID, label, resize.TariffID); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) AdminResizeVolume(ctx context.Context, req *grpcapi.AdminResizeVolumeRequest) (*grpcapi.Empty, error) {
+	resize := model.AdminVolumeResizeRequest{Capacity: model.Quantity(req.Capacity)}
+	if err := s.validate(ctx, &resize); err != nil {
+		return nil, err
+	}
+	if err := s.volumes.AdminResizeVolume(ctx, req.GetVolume().GetNamespaceId(), req.GetVolume().GetLabel(), resize.Capacity); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) PatchVolume(ctx context.Context, req *grpcapi.PatchVolumeRequest) (*grpcapi.Volume, error) {
+	nsID, label := req.GetVolume().GetNamespaceId(), req.GetVolume().GetLabel()
+	if err := namespaceAccess(ctx, nsID, middleware.WriteNamespaceAccess); err != nil {
+		return nil, err
+	}
+	patch, err := model.ParseVolumePatch(req.MergePatch)
+	if err != nil {
+		return nil, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+	ret, err := s.volumes.PatchVolume(ctx, nsID, label, patch)
+	if err != nil {
+		return nil, err
+	}
+	maskForNonAdmin(ctx, &ret)
+	return volumeResponse(ret), nil
+}
+
+func (s *service) ListNamespaceVolumes(ctx context.Context, req *grpcapi.ListNamespaceVolumesRequest) (*grpcapi.VolumesResponse, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.ReadNamespaceAccess); err != nil {
+		return nil, err
+	}
+	page, err := pageRequest(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := s.volumes.GetNamespaceVolumes(ctx, req.NamespaceId, page)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ret.Volumes {
+		maskForNonAdmin(ctx, &ret.Volumes[i])
+	}
+	return volumesResponse(ret), nil
+}
+
+func (s *service) ListUserVolumes(ctx context.Context, req *grpcapi.ListUserVolumesRequest) (*grpcapi.VolumesResponse, error) {
+	page, err := pageRequest(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := s.volumes.GetUserVolumes(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ret.Volumes {
+		maskForNonAdmin(ctx, &ret.Volumes[i])
+	}
+	return volumesResponse(ret), nil
+}
+
+func (s *service) ListAllVolumes(ctx context.Context, req *grpcapi.ListAllVolumesRequest) (*grpcapi.VolumesResponse, error) {
+	page, err := pageRequest(req.Page)
+	if err != nil {
+		return nil, err
+	}
+	ret, err := s.volumes.GetAllVolumes(ctx, page, req.Sort, req.Filters...)
+	if err != nil {
+		return nil, err
+	}
+	return volumesResponse(ret), nil
+}
+
+func (s *service) GetVolumeViews(ctx context.Context, req *grpcapi.VolumeRef) (*grpcapi.VolumeViews, error) {
+	ret, err := s.volumes.GetVolumeViews(ctx, req.NamespaceId, req.Label)
+	if err != nil {
+		return nil, err
+	}
+	return volumeViewsResponse(ret), nil
+}
+
+func (s *service) GetNamespaceVolumeViews(ctx context.Context, req *grpcapi.GetNamespaceVolumeViewsRequest) (*grpcapi.NamespaceVolumeViews, error) {
+	ret, err := s.volumes.GetNamespaceVolumeViews(ctx, req.NamespaceId)
+	if err != nil {
+		return nil, err
+	}
+	views := &grpcapi.NamespaceVolumeViews{Volumes: make([]*grpcapi.VolumeViews, len(ret.Volumes))}
+	for i := range ret.Volumes {
+		views.Volumes[i] = volumeViewsResponse(ret.Volumes[i])
+	}
+	return views, nil
+}
+
+func (s *service) DeleteVolume(ctx context.Context, req *grpcapi.VolumeRef) (*grpcapi.Empty, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.DeleteNamespaceAccess); err != nil {
+		return nil, err
+	}
+	if err := s.volumes.DeleteVolume(ctx, req.NamespaceId, req.Label); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) DeleteNamespaceVolumes(ctx context.Context, req *grpcapi.DeleteNamespaceVolumesRequest) (*grpcapi.Empty, error) {
+	if err := namespaceAccess(ctx, req.NamespaceId, middleware.DeleteNamespaceAccess); err != nil {
+		return nil, err
+	}
+	if err := s.volumes.DeleteAllNamespaceVolumes(ctx, req.NamespaceId); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) DeleteUserVolumes(ctx context.Context, req *grpcapi.Empty) (*grpcapi.Empty, error) {
+	if err := s.volumes.DeleteAllUserVolumes(ctx); err != nil {
+		return nil, err
+	}
+	return &grpcapi.Empty{}, nil
+}
+
+func (s *service) ReconcileBilling(ctx context.Context, req *grpcapi.ReconcileBillingRequest) (*grpcapi.BillingReconciliationReport, error) {
+	ret, err := s.volumes.ReconcileBilling(ctx, req.Fix)
+	if err != nil {
+		return nil, err
+	}
+	return billingReconciliationResponse(ret), nil
+}
+
+func (s *service) MigrateTariff(ctx context.Context, req *grpcapi.MigrateTariffRequest) (*grpcapi.TariffMigrationReport, error) {
+	migration := model.TariffMigrationRequest{
+		FromTariffID: req.FromTariffId,
+		ToTariffID:   req.ToTariffId,
+		BatchSize:    int(req.BatchSize),
+		DryRun:       req.DryRun,
+	}
+	if err := s.validate(ctx, &migration); err != nil {
+		return nil, err
+	}
+	ret, err := s.volumes.MigrateTariff(ctx, migration)
+	if err != nil {
+		return nil, err
+	}
+	return tariffMigrationResponse(ret), nil
+}
+
+// GetMetering has the same time range defaults as REST API
+func (s *service) GetMetering(ctx context.Context, req *grpcapi.GetMeteringRequest) (*grpcapi.MeteringReport, error) {
+	to := time.Now()
+	if req.To != nil {
+		t, err := ptypes.Timestamp(req.To)
+		if err != nil {
+			return nil, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+		}
+		to = t
+	}
+	from := to.Add(-server.DefaultMeteringRange)
+	if req.From != nil {
+		t, err := ptypes.Timestamp(req.From)
+		if err != nil {
+			return nil, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+		}
+		from = t
+	}
+	ret, err := s.volumes.GetMetering(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return meteringResponse(ret), nil
+}
@@ -0,0 +1,52 @@
+package model
+
+import "time"
+
+// CapacityAlertKind describes what reached capacity threshold
+type CapacityAlertKind string
+
+const (
+	// StorageCapacityAlert is emitted when storage fill level reaches threshold
+	StorageCapacityAlert CapacityAlertKind = "storage"
+	// NamespaceCapacityAlert is emitted when namespace volumes reach threshold of namespace quota
+	NamespaceCapacityAlert CapacityAlertKind = "namespace"
+)
+
+// CapacityAlert describes reached capacity threshold
+//
+// swagger:model
+type CapacityAlert struct {
+	Kind CapacityAlertKind `json:"kind"`
+
+	// Storage name or namespace ID
+	Name string `json:"name"`
+
+	// Reached threshold in percents
+	Threshold float64 `json:"threshold"`
+
+	// Current usage in percents
+	Usage float64 `json:"usage"`
+
+	Used Quantity `json:"used"`
+
+	Limit Quantity `json:"limit"`
+
+	Time time.Time `json:"time"`
+}
+
+// CapacityThreshold is highest threshold reached by storage or namespace.
+// It is kept in database, so event is emitted once by all replicas and is not repeated after restart.
+type CapacityThreshold struct {
+	tableName struct{} `sql:"capacity_thresholds"`
+
+	// Consumer of threshold state, alerts and webhooks are configured with own thresholds
+	Source string `sql:"source,pk"`
+
+	Kind CapacityAlertKind `sql:"kind,pk"`
+
+	// Storage name or namespace ID
+	Name string `sql:"name,pk"`
+
+	// Reached threshold in percents
+	Threshold float64 `sql:"threshold,notnull"`
+}
@@ -0,0 +1,126 @@
+package model
+
+import (
+	"time"
+
+	billing "github.com/containerum/bill-external/models"
+	kubeModel "github.com/containerum/kube-client/pkg/model"
+)
+
+// BillingSubscription describes resource subscription to tariff known to billing
+//
+// swagger:model
+type BillingSubscription struct {
+	// swagger:strfmt uuid
+	ResourceID string `json:"resource_id"`
+
+	ResourceLabel string `json:"resource_label,omitempty"`
+
+	ResourceType billing.ResourceType `json:"resource_type"`
+
+	// swagger:strfmt uuid
+	TariffID string `json:"tariff_id"`
+
+	// swagger:strfmt uuid
+	UserID string `json:"user_id,omitempty"`
+
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+}
+
+// ReconciliationVolume describes volume which has no matching billing subscription
+//
+// swagger:model
+type ReconciliationVolume struct {
+	// swagger:strfmt uuid
+	ID string `json:"id"`
+
+	// swagger:strfmt uuid
+	NamespaceID string `json:"namespace_id"`
+
+	Label string `json:"label"`
+
+	// swagger:strfmt uuid
+	TariffID string `json:"tariff_id"`
+
+	// Subscriptions are fixed on behalf of volume owner
+	//
+	// swagger:strfmt uuid
+	OwnerUserID string `json:"owner_user_id"`
+}
+
+// TariffMismatch describes volume which tariff differs from tariff of its billing subscription
+//
+// swagger:model
+type TariffMismatch struct {
+	ReconciliationVolume
+
+	// swagger:strfmt uuid
+	SubscriptionTariffID string `json:"subscription_tariff_id"`
+}
+
+// BillingReconciliationReport describes differences between billing subscriptions and live tariffed volumes
+//
+// swagger:model
+type BillingReconciliationReport struct {
+	// Differences was fixed: orphaned subscriptions removed,
+	// missing subscriptions created, mismatched ones resubscribed to volume tariff
+	Fix bool `json:"fix"`
+
+	SubscriptionsWithoutVolumes []BillingSubscription `json:"subscriptions_without_volumes"`
+
+	VolumesWithoutSubscriptions []ReconciliationVolume `json:"volumes_without_subscriptions"`
+
+	TariffMismatches []TariffMismatch `json:"tariff_mismatches"`
+
+	// Fixes which failed
+	Failed []kubeModel.ImportResult `json:"failed"`
+}
+
+// TariffCacheStats describes state of billing tariffs cache
+//
+// swagger:model
+type TariffCacheStats struct {
+	Enabled bool `json:"enabled"`
+
+	// Time to live of cached tariff
+	TTL string `json:"ttl,omitempty"`
+
+	// Number of cached tariffs
+	Size int `json:"size"`
+
+	MaxSize int `json:"max_size"`
+
+	Hits uint64 `json:"hits"`
+
+	Misses uint64 `json:"misses"`
+
+	Evictions uint64 `json:"evictions"`
+
+	HitRatio float64 `json:"hit_ratio"`
+}
+
+// BillingFailure describes failure simulated by fake billing
+//
+// swagger:model
+type BillingFailure struct {
+	// Billing client method, e.g. Subscribe or GetVolumeTariff. Empty matches all methods.
+	Method string `json:"method"`
+
+	// Number of calls to fail, zero fails calls until failures are replaced
+	Times int `json:"times"`
+
+	// HTTP status of returned error, 503 by default
+	Status int `json:"status,omitempty" binding:"omitempty,min=400,max=599"`
+}
+
+// FakeBillingState describes subscriptions and simulated failures of fake billing
+//
+// swagger:model
+type FakeBillingState struct {
+	// Where tariffs was loaded from
+	Source string `json:"source"`
+
+	Subscriptions []BillingSubscription `json:"subscriptions"`
+
+	Failures []BillingFailure `json:"failures"`
+}
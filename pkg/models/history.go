@@ -0,0 +1,107 @@
+package model
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// StorageUsageSnapshot is a daily record of storage usage
+//
+// swagger:model
+type StorageUsageSnapshot struct {
+	tableName struct{} `sql:"storage_usage_history"`
+
+	StorageName string `sql:"storage_name,pk" json:"storage_name"`
+
+	Date time.Time `sql:"date,pk,type:date" json:"date"`
+
+	Size Quantity `sql:"size,notnull" json:"size"`
+
+	Used Quantity `sql:"used,notnull" json:"used"`
+}
+
+// NamespaceUsageSnapshot is a daily record of space allocated by namespace volumes on storage
+//
+// swagger:model
+type NamespaceUsageSnapshot struct {
+	tableName struct{} `sql:"namespace_usage_history"`
+
+	StorageName string `sql:"storage_name,pk" json:"storage_name"`
+
+	// swagger:strfmt uuid
+	NamespaceID string `sql:"ns_id,pk,type:text" json:"namespace_id"`
+
+	Date time.Time `sql:"date,pk,type:date" json:"date"`
+
+	Volumes int `sql:"volumes,notnull" json:"volumes"`
+
+	Capacity Quantity `sql:"capacity,notnull" json:"capacity"`
+}
+
+// NamespaceUsageGrowth describes how fast namespace allocates space on storage
+//
+// swagger:model
+type NamespaceUsageGrowth struct {
+	NamespaceID string `json:"namespace_id"`
+
+	Capacity Quantity `json:"capacity"`
+
+	// Allocation growth per day
+	GrowthPerDay Quantity `json:"growth_per_day"`
+
+	unit Unit
+}
+
+func (g NamespaceUsageGrowth) MarshalJSON() ([]byte, error) {
+	type namespaceUsageGrowth NamespaceUsageGrowth
+	return json.Marshal(struct {
+		namespaceUsageGrowth
+		Capacity     float64 `json:"capacity"`
+		GrowthPerDay float64 `json:"growth_per_day"`
+	}{namespaceUsageGrowth(g), g.Capacity.In(g.unit), g.GrowthPerDay.In(g.unit)})
+}
+
+// StorageForecast describes storage usage trend and projected date when storage becomes full
+//
+// swagger:model
+type StorageForecast struct {
+	Name string `json:"name"`
+
+	Size Quantity `json:"size"`
+
+	Used Quantity `json:"used"`
+
+	// Number of daily snapshots used for projection
+	Samples int `json:"samples"`
+
+	// Usage growth per day estimated with linear regression
+	GrowthPerDay Quantity `json:"growth_per_day"`
+
+	// Not set if usage does not grow or there are not enough samples
+	DaysToFull *float64 `json:"days_to_full,omitempty"`
+
+	FullDate *time.Time `json:"full_date,omitempty"`
+
+	// Namespaces sorted by allocation growth, fastest first
+	Namespaces []NamespaceUsageGrowth `json:"namespaces"`
+
+	unit Unit
+}
+
+// SetUnit sets unit used to represent quantities in JSON
+func (f *StorageForecast) SetUnit(unit Unit) {
+	f.unit = unit
+	for i := range f.Namespaces {
+		f.Namespaces[i].unit = unit
+	}
+}
+
+func (f StorageForecast) MarshalJSON() ([]byte, error) {
+	type storageForecast StorageForecast
+	return json.Marshal(struct {
+		storageForecast
+		Size         float64 `json:"size"`
+		Used         float64 `json:"used"`
+		GrowthPerDay float64 `json:"growth_per_day"`
+	}{storageForecast(f), f.Size.In(f.unit), f.Used.In(f.unit), f.GrowthPerDay.In(f.unit)})
+}
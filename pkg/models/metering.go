@@ -0,0 +1,122 @@
+package model
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+// MeteringPeriod is a period during which volume had constant capacity, tariff, storage and namespace.
+// Period of alive volume is open (has no end time).
+//
+// swagger:model
+type MeteringPeriod struct {
+	tableName struct{} `sql:"volume_metering"`
+
+	ID int64 `sql:"id,pk" json:"-"`
+
+	// swagger:strfmt uuid
+	VolumeID string `sql:"volume_id,type:uuid,notnull" json:"volume_id"`
+
+	Label string `sql:"label,notnull" json:"label"`
+
+	// swagger:strfmt uuid
+	NamespaceID string `sql:"ns_id,type:text,notnull" json:"namespace_id"`
+
+	StorageName string `sql:"storage_name,notnull" json:"storage_name"`
+
+	// Empty for volumes created or resized by admin without tariff
+	TariffID string `sql:"tariff_id,type:text,notnull" json:"tariff_id"`
+
+	Capacity Quantity `sql:"capacity,notnull" json:"capacity"`
+
+	StartTime time.Time `sql:"start_time,notnull,default:now()" json:"start_time"`
+
+	EndTime *time.Time `sql:"end_time" json:"end_time,omitempty"`
+}
+
+// NewMeteringPeriod returns open metering period for volume current state
+func NewMeteringPeriod(v Volume) MeteringPeriod {
+	period := MeteringPeriod{
+		VolumeID:    v.ID,
+		Label:       v.Label,
+		NamespaceID: v.NamespaceID,
+		StorageName: v.StorageName,
+		Capacity:    v.Capacity,
+	}
+	if v.TariffID != nil {
+		period.TariffID = *v.TariffID
+	}
+	return period
+}
+
+// SameAllocation reports if periods have the same metered attributes
+func (p MeteringPeriod) SameAllocation(other MeteringPeriod) bool {
+	return p.NamespaceID == other.NamespaceID &&
+		p.StorageName == other.StorageName &&
+		p.TariffID == other.TariffID &&
+		p.Capacity == other.Capacity
+}
+
+// MeteringRecord is an allocated space of volume with given tariff, storage and namespace over time range
+//
+// swagger:model
+type MeteringRecord struct {
+	// swagger:strfmt uuid
+	VolumeID string `json:"volume_id"`
+
+	Label string `json:"label"`
+
+	// swagger:strfmt uuid
+	NamespaceID string `json:"namespace_id"`
+
+	StorageName string `json:"storage_name"`
+
+	TariffID string `json:"tariff_id"`
+
+	GiBHours float64 `json:"gib_hours"`
+}
+
+// MeteringReport contains allocated GiB-hours for time range
+//
+// swagger:model
+type MeteringReport struct {
+	From time.Time `json:"from"`
+
+	To time.Time `json:"to"`
+
+	Records []MeteringRecord `json:"records"`
+
+	ByTariff map[string]float64 `json:"by_tariff"`
+
+	ByStorage map[string]float64 `json:"by_storage"`
+
+	ByNamespace map[string]float64 `json:"by_namespace"`
+
+	Total float64 `json:"total"`
+}
+
+var meteringCSVHeader = []string{"volume_id", "label", "namespace_id", "storage_name", "tariff_id", "gib_hours"}
+
+// WriteCSV writes report records as CSV with header
+func (r MeteringReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(meteringCSVHeader); err != nil {
+		return err
+	}
+	for _, rec := range r.Records {
+		if err := cw.Write([]string{
+			rec.VolumeID,
+			rec.Label,
+			rec.NamespaceID,
+			rec.StorageName,
+			rec.TariffID,
+			strconv.FormatFloat(rec.GiBHours, 'f', -1, 64),
+		}); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
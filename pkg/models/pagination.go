@@ -0,0 +1,165 @@
+package model
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Page size limits of list endpoints
+const (
+	DefaultPerPage = 100
+	MaxPerPage     = 1000
+)
+
+// PageRequest describes requested page of list.
+// List is not paginated if PerPage is zero.
+type PageRequest struct {
+	// 1-based page number for offset pagination
+	Page    int
+	PerPage int
+
+	// Position after which keyset page starts, taken from Pagination.NextCursor
+	Cursor string
+}
+
+// Paginated returns true if only page of list requested
+func (r PageRequest) Paginated() bool {
+	return r.PerPage > 0
+}
+
+// Validate checks page request consistency
+func (r PageRequest) Validate() error {
+	switch {
+	case r.PerPage < 0 || r.PerPage > MaxPerPage:
+		return fmt.Errorf("per page limit must be between 1 and %d", MaxPerPage)
+	case r.Page < 0:
+		return fmt.Errorf("page number must be positive")
+	case r.Page > 1 && r.Cursor != "":
+		return fmt.Errorf("page number and cursor are mutually exclusive")
+	case r.Cursor != "" && !r.Paginated():
+		return fmt.Errorf("cursor requires per page limit")
+	}
+	return nil
+}
+
+// Pagination describes page of list returned by API
+//
+// swagger:model
+type Pagination struct {
+	// Total number of items matching request
+	Total int `json:"total"`
+
+	// Page number, omitted for keyset pages
+	Page int `json:"page,omitempty"`
+
+	PerPage int `json:"per_page,omitempty"`
+
+	// Cursor of the next page, empty on the last page
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// HasNext returns true if there are items after page
+func (p Pagination) HasNext() bool {
+	return p.NextCursor != "" || (p.Page > 0 && p.PerPage > 0 && p.Page*p.PerPage < p.Total)
+}
+
+// LastPage returns number of the last page for offset pagination
+func (p Pagination) LastPage() int {
+	if p.PerPage == 0 || p.Total == 0 {
+		return 1
+	}
+	return (p.Total + p.PerPage - 1) / p.PerPage
+}
+
+// PageBounds selects page of in-memory list of n items sorted by key.
+// Key of item is used as keyset cursor.
+func PageBounds(n int, key func(i int) string, req PageRequest) (from, to int, page Pagination, err error) {
+	page.Total = n
+	if !req.Paginated() {
+		return 0, n, page, nil
+	}
+	page.PerPage = req.PerPage
+
+	if req.Cursor != "" {
+		after, err := DecodeCursor(req.Cursor, 1)
+		if err != nil {
+			return 0, 0, page, err
+		}
+		for from < n && key(from) <= after[0] {
+			from++
+		}
+	} else {
+		page.Page = req.Page
+		if page.Page == 0 {
+			page.Page = 1
+		}
+		from = (page.Page - 1) * req.PerPage
+		if from > n {
+			from = n
+		}
+	}
+
+	to = from + req.PerPage
+	if to > n {
+		to = n
+	}
+	if to < n && to > from {
+		page.NextCursor = EncodeCursor(key(to - 1))
+	}
+	return from, to, page, nil
+}
+
+// VolumeCursor is a keyset position in volumes list ordered by create time and id
+type VolumeCursor struct {
+	CreateTime time.Time
+	ID         string
+}
+
+// Cursor returns cursor pointing after volume
+func (v *Volume) Cursor() VolumeCursor {
+	ret := VolumeCursor{ID: v.ID}
+	if v.CreateTime != nil {
+		ret.CreateTime = *v.CreateTime
+	}
+	return ret
+}
+
+func (c VolumeCursor) String() string {
+	return EncodeCursor(c.CreateTime.UTC().Format(time.RFC3339Nano), c.ID)
+}
+
+// ParseVolumeCursor parses cursor returned in volumes list pagination
+func ParseVolumeCursor(cursor string) (VolumeCursor, error) {
+	parts, err := DecodeCursor(cursor, 2)
+	if err != nil {
+		return VolumeCursor{}, err
+	}
+	createTime, err := time.Parse(time.RFC3339Nano, parts[0])
+	if err != nil || parts[1] == "" {
+		return VolumeCursor{}, fmt.Errorf("invalid cursor")
+	}
+	return VolumeCursor{CreateTime: createTime, ID: parts[1]}, nil
+}
+
+// cursorSeparator never appears in cursor parts (times, uuids and resource names)
+const cursorSeparator = "\n"
+
+// EncodeCursor makes opaque url-safe cursor from key parts
+func EncodeCursor(parts ...string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strings.Join(parts, cursorSeparator)))
+}
+
+// DecodeCursor decodes cursor made by EncodeCursor checking number of key parts
+func DecodeCursor(cursor string, parts int) ([]string, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	ret := strings.Split(string(data), cursorSeparator)
+	if len(ret) != parts {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+	return ret, nil
+}
@@ -0,0 +1,70 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVolumeCursor(t *testing.T) {
+	createTime := time.Date(2018, 5, 1, 10, 0, 0, 123456000, time.UTC)
+	vol := Volume{Resource: Resource{ID: "5f9ee0ac-1e4b-4a1e-9a3c-5a0d7a3e7f10", CreateTime: &createTime}}
+
+	cursor, err := ParseVolumeCursor(vol.Cursor().String())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cursor.ID != vol.ID || !cursor.CreateTime.Equal(createTime) {
+		t.Errorf("cursor not restored: %+v", cursor)
+	}
+
+	for _, invalid := range []string{"!!!", EncodeCursor("name"), EncodeCursor("yesterday", vol.ID)} {
+		if _, err := ParseVolumeCursor(invalid); err == nil {
+			t.Errorf("expected error for cursor %q", invalid)
+		}
+	}
+}
+
+func TestPageBounds(t *testing.T) {
+	names := []string{"a", "b", "c", "d", "e"}
+	key := func(i int) string { return names[i] }
+
+	from, to, page, err := PageBounds(len(names), key, PageRequest{})
+	if err != nil || from != 0 || to != 5 || page.Total != 5 || page.HasNext() {
+		t.Fatalf("expected whole list, got [%d, %d) %+v (%v)", from, to, page, err)
+	}
+
+	from, to, page, err = PageBounds(len(names), key, PageRequest{Page: 2, PerPage: 2})
+	if err != nil || from != 2 || to != 4 || page.Page != 2 || page.LastPage() != 3 || !page.HasNext() {
+		t.Fatalf("expected second page, got [%d, %d) %+v (%v)", from, to, page, err)
+	}
+
+	var got []string
+	req := PageRequest{PerPage: 2}
+	for i := 0; i < len(names); i++ {
+		from, to, page, err = PageBounds(len(names), key, req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, names[from:to]...)
+		if page.NextCursor == "" {
+			break
+		}
+		req.Cursor = page.NextCursor
+	}
+	if len(got) != len(names) {
+		t.Errorf("expected all items walking cursors, got %v", got)
+	}
+}
+
+func TestPageRequestValidate(t *testing.T) {
+	for _, req := range []PageRequest{
+		{PerPage: MaxPerPage + 1},
+		{Page: -1, PerPage: 10},
+		{Page: 2, PerPage: 10, Cursor: "x"},
+		{Cursor: "x"},
+	} {
+		if err := req.Validate(); err == nil {
+			t.Errorf("expected error for %+v", req)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Quantity is an amount of storage space in bytes.
+// In requests it may be provided as kubernetes quantity string ("512Mi", "1.5Ti")
+// or, for compatibility, as a number of GiB.
+//
+// swagger:strfmt quantity
+type Quantity int64
+
+const (
+	Byte Quantity = 1
+
+	KiB = 1024 * Byte
+	MiB = 1024 * KiB
+	GiB = 1024 * MiB
+	TiB = 1024 * GiB
+	PiB = 1024 * TiB
+	EiB = 1024 * PiB
+
+	KB = 1000 * Byte
+	MB = 1000 * KB
+	GB = 1000 * MB
+	TB = 1000 * GB
+	PB = 1000 * TB
+	EB = 1000 * PB
+)
+
+// GiBytes returns quantity of n GiB. Tariffs and kube-api operate in GiB.
+func GiBytes(n int) Quantity {
+	return Quantity(n) * GiB
+}
+
+// suffixes ordered from the largest so String picks the shortest representation
+var quantitySuffixes = []struct {
+	suffix string
+	size   Quantity
+}{
+	{"Ei", EiB}, {"Pi", PiB}, {"Ti", TiB}, {"Gi", GiB}, {"Mi", MiB}, {"Ki", KiB},
+	{"E", EB}, {"P", PB}, {"T", TB}, {"G", GB}, {"M", MB}, {"k", KB},
+}
+
+func suffixSize(suffix string) (Quantity, bool) {
+	if suffix == "" {
+		return Byte, true
+	}
+	for _, s := range quantitySuffixes {
+		if s.suffix == suffix {
+			return s.size, true
+		}
+	}
+	return 0, false
+}
+
+// ParseQuantity parses kubernetes quantity string like "512Mi", "1.5Ti" or "1000000".
+// Fractional byte amounts are rounded up.
+func ParseQuantity(str string) (Quantity, error) {
+	str = strings.TrimSpace(str)
+	numEnd := strings.IndexFunc(str, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if numEnd < 0 {
+		numEnd = len(str)
+	}
+	number, suffix := str[:numEnd], str[numEnd:]
+	if number == "" {
+		return 0, fmt.Errorf("invalid quantity %q", str)
+	}
+	size, ok := suffixSize(suffix)
+	if !ok {
+		return 0, fmt.Errorf("invalid quantity %q: unknown suffix %q", str, suffix)
+	}
+	value, ok := new(big.Rat).SetString(number)
+	if !ok {
+		return 0, fmt.Errorf("invalid quantity %q", str)
+	}
+	value.Mul(value, new(big.Rat).SetInt64(int64(size)))
+	bytes := new(big.Int).Quo(value.Num(), value.Denom())
+	if new(big.Rat).SetInt(bytes).Cmp(value) < 0 {
+		bytes.Add(bytes, big.NewInt(1))
+	}
+	if !bytes.IsInt64() {
+		return 0, fmt.Errorf("invalid quantity %q: value is too large", str)
+	}
+	return Quantity(bytes.Int64()), nil
+}
+
+// String returns quantity in kubernetes format using the largest binary suffix which represents it exactly
+func (q Quantity) String() string {
+	if q != 0 {
+		for _, s := range quantitySuffixes[:6] {
+			if q%s.size == 0 {
+				return strconv.FormatInt(int64(q/s.size), 10) + s.suffix
+			}
+		}
+	}
+	return strconv.FormatInt(int64(q), 10)
+}
+
+// In returns quantity expressed in unit
+func (q Quantity) In(unit Unit) float64 {
+	return float64(q) / float64(unit.Size())
+}
+
+// CeilIn returns quantity expressed in unit rounded up
+func (q Quantity) CeilIn(unit Unit) uint {
+	return uint(math.Ceil(q.In(unit)))
+}
+
+// GiB returns quantity in GiB rounded up
+func (q Quantity) GiB() int {
+	return int(q.CeilIn(UnitGiB))
+}
+
+// MarshalJSON represents quantity as a number of GiB
+func (q Quantity) MarshalJSON() ([]byte, error) {
+	return json.Marshal(q.In(UnitGiB))
+}
+
+// UnmarshalJSON accepts quantity string or number of GiB
+func (q *Quantity) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		parsed, err := ParseQuantity(str)
+		if err != nil {
+			return err
+		}
+		*q = parsed
+		return nil
+	}
+	var gib float64
+	if err := json.Unmarshal(data, &gib); err != nil {
+		return err
+	}
+	*q = Quantity(math.Ceil(gib * float64(GiB)))
+	return nil
+}
+
+// Unit is a unit used to represent quantities in responses
+type Unit string
+
+const (
+	UnitBytes Unit = "B"
+	UnitKiB   Unit = "Ki"
+	UnitMiB   Unit = "Mi"
+	UnitGiB   Unit = "Gi"
+	UnitTiB   Unit = "Ti"
+	UnitPiB   Unit = "Pi"
+	UnitKB    Unit = "k"
+	UnitMB    Unit = "M"
+	UnitGB    Unit = "G"
+	UnitTB    Unit = "T"
+	UnitPB    Unit = "P"
+)
+
+// DefaultUnit is used when client did not ask for specific one
+const DefaultUnit = UnitGiB
+
+// ParseUnit parses unit name. Empty string means default unit.
+func ParseUnit(str string) (Unit, error) {
+	switch str {
+	case "":
+		return DefaultUnit, nil
+	case "B", "bytes":
+		return UnitBytes, nil
+	}
+	unit := Unit(strings.TrimSuffix(str, "B"))
+	if _, ok := suffixSize(string(unit)); !ok || unit == "" {
+		return "", fmt.Errorf("unknown unit %q", str)
+	}
+	return unit, nil
+}
+
+// Size returns unit size in bytes. Empty unit is treated as default.
+func (u Unit) Size() Quantity {
+	if u == UnitBytes {
+		return Byte
+	}
+	size, ok := suffixSize(string(u))
+	if !ok || u == "" {
+		size, _ = suffixSize(string(DefaultUnit))
+	}
+	return size
+}
@@ -0,0 +1,118 @@
+package model
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestParseQuantity(t *testing.T) {
+	tests := []struct {
+		str      string
+		expected Quantity
+		fail     bool
+	}{
+		{str: "512Mi", expected: 512 * MiB},
+		{str: "1.5Ti", expected: 1536 * GiB},
+		{str: "10Gi", expected: 10 * GiB},
+		{str: "1G", expected: GB},
+		{str: "100", expected: 100},
+		{str: "0.5", expected: 1},
+		{str: "", fail: true},
+		{str: "Gi", fail: true},
+		{str: "10Xi", fail: true},
+		{str: "1.2.3Gi", fail: true},
+	}
+	for _, test := range tests {
+		q, err := ParseQuantity(test.str)
+		if test.fail {
+			if err == nil {
+				t.Errorf("%q: expected error, got %d", test.str, q)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: unexpected error %v", test.str, err)
+		} else if q != test.expected {
+			t.Errorf("%q: expected %d, got %d", test.str, test.expected, q)
+		}
+	}
+}
+
+func TestQuantityJSON(t *testing.T) {
+	var req AdminVolumeResizeRequest
+	if err := json.Unmarshal([]byte(`{"capacity":"512Mi"}`), &req); err != nil || req.Capacity != 512*MiB {
+		t.Errorf("quantity string: got %d, %v", req.Capacity, err)
+	}
+	if err := json.Unmarshal([]byte(`{"capacity":2}`), &req); err != nil || req.Capacity != 2*GiB {
+		t.Errorf("legacy GiB number: got %d, %v", req.Capacity, err)
+	}
+
+	now := time.Now()
+	vol := Volume{Resource: Resource{CreateTime: &now}, Capacity: 512 * MiB}
+	vol.SetUnit(UnitMiB)
+	data, err := json.Marshal(vol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp map[string]interface{}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp["capacity"] != 512.0 {
+		t.Errorf("expected capacity 512 MiB, got %v", resp["capacity"])
+	}
+
+	if kube := vol.ToKube(); kube.Capacity != 1 {
+		t.Errorf("expected kube capacity rounded up to 1 GiB, got %d", kube.Capacity)
+	}
+
+	data, err = json.Marshal(VolumeResponse{Volume: vol.ToKube(), Capacity: vol.Capacity.In(UnitGiB)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp["capacity"] != 0.5 {
+		t.Errorf("expected response capacity 0.5 GiB, got %v", resp["capacity"])
+	}
+}
+
+func TestStorageDetailsJSON(t *testing.T) {
+	details := StorageDetails{
+		Storage:      Storage{Name: "storage", Size: 2 * GiB, Used: GiB},
+		VolumesTotal: 1,
+		Namespaces:   []NamespaceStorageUsage{{NamespaceID: "ns", Volumes: 1, Capacity: GiB}},
+	}
+	details.SetUnit(UnitMiB)
+	data, err := json.Marshal(details)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp struct {
+		Name         string  `json:"name"`
+		Size         float64 `json:"size"`
+		VolumesTotal int     `json:"volumes_total"`
+		Namespaces   []struct {
+			Capacity float64 `json:"capacity"`
+		} `json:"namespaces"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Name != "storage" || resp.Size != 2048 || resp.VolumesTotal != 1 || resp.Namespaces[0].Capacity != 1024 {
+		t.Errorf("unexpected storage details JSON: %s", data)
+	}
+}
+
+func TestParseUnit(t *testing.T) {
+	for str, expected := range map[string]Unit{"": UnitGiB, "B": UnitBytes, "MiB": UnitMiB, "Ti": UnitTiB, "GB": UnitGB} {
+		if unit, err := ParseUnit(str); err != nil || unit != expected {
+			t.Errorf("%q: expected %s, got %s (%v)", str, expected, unit, err)
+		}
+	}
+	if _, err := ParseUnit("parsec"); err == nil {
+		t.Error("expected error for unknown unit")
+	}
+}
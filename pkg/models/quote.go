@@ -0,0 +1,97 @@
+package model
+
+import "encoding/json"
+
+// VolumeQuoteAction is an action which quote is requested for
+type VolumeQuoteAction string
+
+const (
+	QuoteCreate VolumeQuoteAction = "create"
+	QuoteResize VolumeQuoteAction = "resize"
+)
+
+// VolumeQuoteRequest describes volume creation or resize to quote.
+// For creation it has the same fields as VolumeCreateRequest,
+// for resize label identifies existing volume and storage is ignored.
+//
+// swagger:model
+type VolumeQuoteRequest struct {
+	// Defaults to "create"
+	Action VolumeQuoteAction `json:"action" binding:"omitempty,eq=create|eq=resize"`
+
+	// swagger:strfmt uuid
+	TariffID string `json:"tariff_id" binding:"required,uuid"`
+
+	Label string `json:"label" binding:"required"`
+
+	Storage string `json:"storage"`
+}
+
+// VolumeQuote describes result of volume creation or resize without performing it
+//
+// swagger:model
+type VolumeQuote struct {
+	Action VolumeQuoteAction `json:"action"`
+
+	// Request would be accepted
+	Feasible bool `json:"feasible"`
+
+	// Reasons why request would be rejected
+	Reasons []string `json:"reasons,omitempty"`
+
+	// Resulting volume capacity
+	Capacity Quantity `json:"capacity"`
+
+	// Current volume capacity, only for resize
+	CurrentCapacity Quantity `json:"current_capacity,omitempty"`
+
+	StorageName string `json:"storage_name,omitempty"`
+
+	// swagger:strfmt uuid
+	TariffID string `json:"tariff_id"`
+
+	TariffLabel string `json:"tariff_label,omitempty"`
+
+	// Tariff price, zero for free volumes
+	Price float64 `json:"price"`
+
+	// Free storage space after request
+	StorageHeadroom Quantity `json:"storage_headroom"`
+
+	// Namespace free volumes quota from namespace tariff
+	QuotaLimit Quantity `json:"quota_limit"`
+
+	// Namespace free volumes capacity before request
+	QuotaUsed Quantity `json:"quota_used"`
+
+	// Namespace quota left after request
+	QuotaHeadroom Quantity `json:"quota_headroom"`
+
+	unit Unit
+}
+
+// SetUnit sets unit used to represent quantities in JSON
+func (q *VolumeQuote) SetUnit(unit Unit) {
+	q.unit = unit
+}
+
+func (q VolumeQuote) MarshalJSON() ([]byte, error) {
+	type quote VolumeQuote
+	return json.Marshal(struct {
+		quote
+		Capacity        float64 `json:"capacity"`
+		CurrentCapacity float64 `json:"current_capacity,omitempty"`
+		StorageHeadroom float64 `json:"storage_headroom"`
+		QuotaLimit      float64 `json:"quota_limit"`
+		QuotaUsed       float64 `json:"quota_used"`
+		QuotaHeadroom   float64 `json:"quota_headroom"`
+	}{
+		quote:           quote(q),
+		Capacity:        q.Capacity.In(q.unit),
+		CurrentCapacity: q.CurrentCapacity.In(q.unit),
+		StorageHeadroom: q.StorageHeadroom.In(q.unit),
+		QuotaLimit:      q.QuotaLimit.In(q.unit),
+		QuotaUsed:       q.QuotaUsed.In(q.unit),
+		QuotaHeadroom:   q.QuotaHeadroom.In(q.unit),
+	})
+}
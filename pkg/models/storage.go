@@ -1,9 +1,11 @@
 package model
 
 import (
+	"encoding/json"
 	"time"
 
 	"git.containerum.net/ch/volume-manager/pkg/errors"
+	kubeModel "github.com/containerum/kube-client/pkg/model"
 	"github.com/go-pg/pg/orm"
 )
 
@@ -15,15 +17,114 @@ type Storage struct {
 
 	Name string `sql:"name,pk,notnull" json:"name" binding:"required"`
 
-	Size int `sql:"size,notnull" json:"size" binding:"gt=0"`
+	Size Quantity `sql:"size,notnull" json:"size" binding:"gt=0"`
 
-	Used int `sql:"used,notnull" json:"used" binding:"gte=0,ltecsfield=Size"`
+	Used Quantity `sql:"used,notnull" json:"used" binding:"gte=0,ltecsfield=Size"`
 
-	Volumes []*Volume `pg:"fk:storage_id" sql:"-" json:"volumes"`
+	Attributes map[string]string `sql:"attributes" json:"attributes,omitempty"`
+
+	StorageAccess `json:"access"`
+
+	Volumes []*Volume `pg:"fk:storage_name" sql:"-" json:"volumes,omitempty"`
 
 	Deleted bool `sql:"deleted,notnull" json:"deleted,omitempty"`
 
 	DeleteTime *time.Time `sql:"delete_time" json:"delete_time,omitempty"`
+
+	// Storage was created by storage classes sync and is deleted when its class disappears
+	Synced bool `sql:"synced,notnull" json:"synced,omitempty"`
+
+	unit Unit
+}
+
+// SetUnit sets unit used to represent storage and its volumes quantities in JSON
+func (s *Storage) SetUnit(unit Unit) {
+	s.unit = unit
+	for _, v := range s.Volumes {
+		v.SetUnit(unit)
+	}
+}
+
+type storageView struct {
+	storage
+	Size float64 `json:"size"`
+	Used float64 `json:"used"`
+}
+
+type storage Storage
+
+func (s Storage) view() storageView {
+	return storageView{
+		storage: storage(s),
+		Size:    s.Size.In(s.unit),
+		Used:    s.Used.In(s.unit),
+	}
+}
+
+func (s Storage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.view())
+}
+
+// Free returns storage space not allocated by volumes
+func (s *Storage) Free() Quantity {
+	return s.Size - s.Used
+}
+
+// Mask removes information not interesting for users
+func (s *Storage) Mask() {
+	s.Attributes = nil
+	s.StorageAccess = StorageAccess{}
+	s.Volumes = nil
+	s.DeleteTime = nil
+}
+
+// StorageAccess restricts consumers which can place volumes on storage.
+// Deny lists take precedence over allow lists, empty allow list allows everyone.
+//
+// swagger:model
+type StorageAccess struct {
+	AllowedNamespaces []string `sql:"allowed_namespaces" pg:",array" json:"allowed_namespaces,omitempty"`
+	DeniedNamespaces  []string `sql:"denied_namespaces" pg:",array" json:"denied_namespaces,omitempty"`
+
+	AllowedUsers []string `sql:"allowed_users" pg:",array" json:"allowed_users,omitempty"`
+	DeniedUsers  []string `sql:"denied_users" pg:",array" json:"denied_users,omitempty"`
+
+	AllowedRoles []string `sql:"allowed_roles" pg:",array" json:"allowed_roles,omitempty"`
+	DeniedRoles  []string `sql:"denied_roles" pg:",array" json:"denied_roles,omitempty"`
+}
+
+// StorageConsumer describes who places volume on storage
+type StorageConsumer struct {
+	NamespaceID string
+	UserID      string
+	Role        string
+}
+
+// Allows checks if consumer can place volumes on storage. Admins are always allowed.
+func (a *StorageAccess) Allows(consumer StorageConsumer) bool {
+	if consumer.Role == "admin" {
+		return true
+	}
+	return listAllows(a.AllowedNamespaces, a.DeniedNamespaces, consumer.NamespaceID) &&
+		listAllows(a.AllowedUsers, a.DeniedUsers, consumer.UserID) &&
+		listAllows(a.AllowedRoles, a.DeniedRoles, consumer.Role)
+}
+
+func listAllows(allowed, denied []string, value string) bool {
+	for _, v := range denied {
+		if v == value {
+			return false
+		}
+	}
+	if len(allowed) == 0 {
+		return true
+	}
+	for _, v := range allowed {
+		if v == value {
+			return true
+		}
+	}
+	return false
 }
 
 func (s *Storage) BeforeInsert(db orm.DB) error {
@@ -39,16 +140,195 @@ func (s *Storage) BeforeInsert(db orm.DB) error {
 
 func (s *Storage) BeforeUpdate(db orm.DB) error {
 	if s.Size < s.Used {
-		return errors.ErrQuotaExceeded().AddDetailF("storage quota exceeded (%d GiB)", s.Used-s.Size)
+		return errors.ErrQuotaExceeded().AddDetailF("storage quota exceeded (%s)", s.Used-s.Size)
 	}
 	return nil
 }
 
+// NamespaceStorageUsage describes how much space of storage is allocated by namespace volumes
+//
+// swagger:model
+type NamespaceStorageUsage struct {
+	NamespaceID string `sql:"ns_id" json:"namespace_id"`
+
+	Volumes int `sql:"volumes" json:"volumes"`
+
+	Capacity Quantity `sql:"capacity" json:"capacity"`
+
+	unit Unit
+}
+
+// SetUnit sets unit used to represent capacity in JSON
+func (u *NamespaceStorageUsage) SetUnit(unit Unit) {
+	u.unit = unit
+}
+
+func (u NamespaceStorageUsage) MarshalJSON() ([]byte, error) {
+	type namespaceStorageUsage NamespaceStorageUsage
+	return json.Marshal(struct {
+		namespaceStorageUsage
+		Capacity float64 `json:"capacity"`
+	}{namespaceStorageUsage(u), u.Capacity.In(u.unit)})
+}
+
+// StorageDetails describes storage with its live volumes and per-namespace usage
+//
+// swagger:model
+type StorageDetails struct {
+	Storage
+
+	VolumesTotal int `json:"volumes_total"`
+
+	// Page of storage volumes included in response
+	VolumesPagination Pagination `json:"volumes_pagination"`
+
+	Namespaces []NamespaceStorageUsage `json:"namespaces"`
+}
+
+// SetUnit sets unit used to represent quantities in JSON
+func (d *StorageDetails) SetUnit(unit Unit) {
+	d.Storage.SetUnit(unit)
+	for i := range d.Namespaces {
+		d.Namespaces[i].SetUnit(unit)
+	}
+}
+
+func (d StorageDetails) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		storageView
+		VolumesTotal int                     `json:"volumes_total"`
+		Namespaces   []NamespaceStorageUsage `json:"namespaces"`
+	}{d.Storage.view(), d.VolumesTotal, d.Namespaces})
+}
+
+// StorageUsageCheck describes difference between recorded storage usage and capacity of its live volumes
+//
+// swagger:model
+type StorageUsageCheck struct {
+	Name string `sql:"name" json:"name"`
+
+	Recorded Quantity `sql:"recorded" json:"recorded"`
+
+	Actual Quantity `sql:"actual" json:"actual"`
+
+	unit Unit
+}
+
+func (c StorageUsageCheck) MarshalJSON() ([]byte, error) {
+	type storageUsageCheck StorageUsageCheck
+	return json.Marshal(struct {
+		storageUsageCheck
+		Recorded float64 `json:"recorded"`
+		Actual   float64 `json:"actual"`
+	}{storageUsageCheck(c), c.Recorded.In(c.unit), c.Actual.In(c.unit)})
+}
+
+// StorageUsageReport contains storages which recorded usage differs from actual one
+//
+// swagger:model
+type StorageUsageReport struct {
+	DryRun bool `json:"dry_run"`
+
+	Discrepancies []StorageUsageCheck `json:"discrepancies"`
+}
+
+// SetUnit sets unit used to represent quantities in JSON
+func (r *StorageUsageReport) SetUnit(unit Unit) {
+	for i := range r.Discrepancies {
+		r.Discrepancies[i].unit = unit
+	}
+}
+
 // UpdateStorageRequest represents request object for updating storage
 //
 // swagger:model
 type UpdateStorageRequest struct {
-	Name *string `json:"name,omitempty"`
-	Size *int    `json:"size,omitempty" binding:"omitempty,gt=0,gtecsfield=Used"`
-	Used *int    `json:"used,omitempty"`
+	Name *string   `json:"name,omitempty"`
+	Size *Quantity `json:"size,omitempty" binding:"omitempty,gt=0,gtecsfield=Used"`
+	Used *Quantity `json:"used,omitempty"`
+
+	Attributes map[string]string `json:"attributes,omitempty"`
+
+	Access *StorageAccess `json:"access,omitempty"`
+}
+
+// StorageClass describes kubernetes storage class which may be used as volumes storage
+//
+// swagger:model
+type StorageClass struct {
+	Name string `json:"name"`
+
+	// Capacity hint, zero if not provided
+	Capacity Quantity `json:"capacity,omitempty"`
+
+	Provisioner string `json:"provisioner,omitempty"`
+
+	Parameters map[string]string `json:"parameters,omitempty"`
+}
+
+// StorageClassesList is a list of storage classes
+//
+// swagger:model
+type StorageClassesList struct {
+	StorageClasses []StorageClass `json:"storage_classes"`
+}
+
+// StorageSyncReport describes changes made during storages synchronization with storage classes
+//
+// swagger:model
+type StorageSyncReport struct {
+	DryRun bool `json:"dry_run"`
+
+	Created []string `json:"created"`
+
+	Updated []string `json:"updated"`
+
+	Deleted []string `json:"deleted"`
+
+	Failed []kubeModel.ImportResult `json:"failed"`
+}
+
+// DefaultImportStorageSize is a size of storage imported only by name
+const DefaultImportStorageSize = 100 * GiB
+
+// StorageImportRequest describes storage to import.
+// For compatibility it may be provided as plain storage name, in this case default size used.
+//
+// swagger:model
+type StorageImportRequest struct {
+	Name string `json:"name"`
+
+	Size Quantity `json:"size"`
+
+	// Initial used capacity, useful when storage volumes will be imported later
+	Used *Quantity `json:"used,omitempty"`
+
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+func (r *StorageImportRequest) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*r = StorageImportRequest{
+			Name: name,
+			Size: DefaultImportStorageSize,
+		}
+		return nil
+	}
+
+	type storageImportRequest StorageImportRequest
+	return json.Unmarshal(data, (*storageImportRequest)(r))
+}
+
+// Validate checks import request consistency
+func (r *StorageImportRequest) Validate() error {
+	switch {
+	case r.Name == "":
+		return errors.ErrRequestValidationFailed().AddDetailF("storage name is required")
+	case r.Size <= 0:
+		return errors.ErrRequestValidationFailed().AddDetailF("storage size must be greater than 0")
+	case r.Used != nil && (*r.Used < 0 || *r.Used > r.Size):
+		return errors.ErrRequestValidationFailed().AddDetailF("storage used must be between 0 and size")
+	}
+	return nil
 }
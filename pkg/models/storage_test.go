@@ -0,0 +1,33 @@
+package model
+
+import "testing"
+
+func TestStorageAccessAllows(t *testing.T) {
+	access := StorageAccess{
+		AllowedNamespaces: []string{"premium-ns", "other-ns"},
+		DeniedUsers:       []string{"blocked-user"},
+		AllowedRoles:      []string{"user"},
+	}
+
+	tests := []struct {
+		name     string
+		consumer StorageConsumer
+		allowed  bool
+	}{
+		{"allowed namespace", StorageConsumer{NamespaceID: "premium-ns", UserID: "user", Role: "user"}, true},
+		{"not allowed namespace", StorageConsumer{NamespaceID: "ns", UserID: "user", Role: "user"}, false},
+		{"denied user", StorageConsumer{NamespaceID: "premium-ns", UserID: "blocked-user", Role: "user"}, false},
+		{"not allowed role", StorageConsumer{NamespaceID: "premium-ns", UserID: "user", Role: "guest"}, false},
+		{"admin", StorageConsumer{NamespaceID: "ns", UserID: "blocked-user", Role: "admin"}, true},
+	}
+	for _, test := range tests {
+		if allowed := access.Allows(test.consumer); allowed != test.allowed {
+			t.Errorf("%s: expected %v, got %v", test.name, test.allowed, allowed)
+		}
+	}
+
+	var unrestricted StorageAccess
+	if !unrestricted.Allows(StorageConsumer{NamespaceID: "ns", UserID: "user", Role: "user"}) {
+		t.Errorf("storage without restrictions must be allowed")
+	}
+}
@@ -0,0 +1,109 @@
+package model
+
+import "time"
+
+// SuspensionKind is a kind of suspended account
+type SuspensionKind string
+
+const (
+	SuspendedUser      SuspensionKind = "user"
+	SuspendedNamespace SuspensionKind = "namespace"
+)
+
+// SuspensionSource describes who suspended account
+type SuspensionSource string
+
+const (
+	SuspendedByAdmin   SuspensionSource = "admin"
+	SuspendedByBilling SuspensionSource = "billing"
+)
+
+// Suspension marks user or namespace which volumes can not be created or resized
+//
+// swagger:model
+type Suspension struct {
+	tableName struct{} `sql:"suspensions"`
+
+	Kind SuspensionKind `sql:"kind,pk" json:"kind"`
+
+	// User or namespace id
+	//
+	// swagger:strfmt uuid
+	ID string `sql:"id,pk,type:text" json:"id"`
+
+	Source SuspensionSource `sql:"source,notnull" json:"source"`
+
+	Reason string `sql:"reason" json:"reason,omitempty"`
+
+	CreateTime *time.Time `sql:"create_time,default:now(),notnull" json:"create_time,omitempty"`
+}
+
+// SuspensionRequest is a request to suspend user or namespace
+//
+// swagger:model
+type SuspensionRequest struct {
+	Kind SuspensionKind `json:"kind" binding:"required,eq=user|eq=namespace"`
+
+	// swagger:strfmt uuid
+	ID string `json:"id" binding:"required,uuid"`
+
+	Reason string `json:"reason"`
+}
+
+// BillingAccountEventType is a type of account event sent by billing
+type BillingAccountEventType string
+
+const (
+	AccountBlocked   BillingAccountEventType = "blocked"
+	AccountUnblocked BillingAccountEventType = "unblocked"
+)
+
+// BillingAccountEvent is sent by billing when user or namespace is blocked or unblocked, e.g. for unpaid account
+//
+// swagger:model
+type BillingAccountEvent struct {
+	Event BillingAccountEventType `json:"event" binding:"required,eq=blocked|eq=unblocked"`
+
+	Kind SuspensionKind `json:"kind" binding:"required,eq=user|eq=namespace"`
+
+	// swagger:strfmt uuid
+	ID string `json:"id" binding:"required,uuid"`
+
+	Reason string `json:"reason"`
+}
+
+// SuspensionReport describes volumes suspended or resumed with account
+//
+// swagger:model
+type SuspensionReport struct {
+	Suspension Suspension `json:"suspension"`
+
+	// Changed volumes
+	Volumes []SuspendedVolume `json:"volumes"`
+}
+
+// SuspendedVolume describes volume suspended or resumed with account
+//
+// swagger:model
+type SuspendedVolume struct {
+	// swagger:strfmt uuid
+	NamespaceID string `json:"namespace_id"`
+
+	Label string `json:"label"`
+
+	Suspended bool `json:"suspended"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// suspensionKeyTimeFormat keeps lexical order of keys equal to time order
+const suspensionKeyTimeFormat = "2006-01-02T15:04:05.000000000Z"
+
+// SortKey returns unique key ordering suspensions by create time
+func (s Suspension) SortKey() string {
+	var createTime time.Time
+	if s.CreateTime != nil {
+		createTime = s.CreateTime.UTC()
+	}
+	return createTime.Format(suspensionKeyTimeFormat) + " " + string(s.Kind) + " " + s.ID
+}
@@ -0,0 +1,64 @@
+package model
+
+// DefaultTariffMigrationBatchSize is used if batch size is not provided in tariff migration request
+const DefaultTariffMigrationBatchSize = 50
+
+// TariffMigrationRequest describes moving all volumes from one tariff to another
+//
+// swagger:model
+type TariffMigrationRequest struct {
+	// swagger:strfmt uuid
+	FromTariffID string `json:"from_tariff_id" binding:"required,uuid"`
+
+	// swagger:strfmt uuid
+	ToTariffID string `json:"to_tariff_id" binding:"required,uuid,nefield=FromTariffID"`
+
+	// Number of volumes migrated between progress checks, 50 by default
+	BatchSize int `json:"batch_size" binding:"omitempty,min=1,max=1000"`
+
+	// Only check which volumes can be migrated
+	DryRun bool `json:"dry_run"`
+}
+
+// TariffMigrationResult describes migration of single volume
+//
+// swagger:model
+type TariffMigrationResult struct {
+	// swagger:strfmt uuid
+	ID string `json:"id"`
+
+	// swagger:strfmt uuid
+	NamespaceID string `json:"namespace_id"`
+
+	Label string `json:"label"`
+
+	OldCapacity Quantity `json:"old_capacity"`
+
+	NewCapacity Quantity `json:"new_capacity"`
+
+	// Volume was moved to new tariff, or can be moved in dry run mode
+	Migrated bool `json:"migrated"`
+
+	Error string `json:"error,omitempty"`
+}
+
+// TariffMigrationReport contains per-volume results of tariff migration
+//
+// swagger:model
+type TariffMigrationReport struct {
+	// swagger:strfmt uuid
+	FromTariffID string `json:"from_tariff_id"`
+
+	// swagger:strfmt uuid
+	ToTariffID string `json:"to_tariff_id"`
+
+	DryRun bool `json:"dry_run"`
+
+	Batches int `json:"batches"`
+
+	Migrated int `json:"migrated"`
+
+	Failed int `json:"failed"`
+
+	Results []TariffMigrationResult `json:"results"`
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"encoding/json"
 	"time"
 
 	"git.containerum.net/ch/volume-manager/pkg/errors"
@@ -16,7 +17,7 @@ type Volume struct {
 
 	Resource
 
-	Capacity int `sql:"capacity,notnull" json:"capacity"`
+	Capacity Quantity `sql:"capacity,notnull" json:"capacity"`
 
 	// swagger:strfmt uuid
 	NamespaceID string `sql:"ns_id,type:text" json:"namespace_id,omitempty"`
@@ -24,9 +25,45 @@ type Volume struct {
 	StorageName string `sql:"storage_name,notnull" json:"storage_name,omitempty"`
 
 	AccessMode model.PersistentVolumeAccessMode `sql:"access_mode,notnull" json:"access_mode,omitempty"`
+
+	// Suspended volume can not be resized, it is detached from workloads in kube
+	Suspended bool `sql:"suspended,notnull" json:"suspended,omitempty"`
+
+	// Cluster volume placed in, empty for volumes created before clusters were introduced
+	Cluster string `sql:"cluster,notnull" json:"cluster,omitempty"`
+
+	// Provisioning status updated by volume status watcher
+	Status VolumeStatus `sql:"status,notnull" json:"status,omitempty"`
+
+	BoundTime *time.Time `sql:"bound_time" json:"bound_time,omitempty"`
+
+	// Last provisioning failure message
+	StatusReason string `sql:"status_reason,notnull" json:"status_reason,omitempty"`
+
+	// User defined key-value pairs, not passed to kubernetes
+	Metadata map[string]string `sql:"metadata" json:"metadata,omitempty"`
+
+	unit Unit
+}
+
+// SetUnit sets unit used to represent capacity in JSON
+func (v *Volume) SetUnit(unit Unit) {
+	v.unit = unit
+}
+
+func (v Volume) MarshalJSON() ([]byte, error) {
+	type volume Volume
+	return json.Marshal(struct {
+		volume
+		Capacity float64 `json:"capacity"`
+	}{volume(v), v.Capacity.In(v.unit)})
 }
 
 func (v *Volume) BeforeInsert(db orm.DB) error {
+	if v.Status == "" {
+		v.Status = VolumeStatusPending
+	}
+
 	cnt, err := db.Model(v).
 		Where("ns_id = ?ns_id").
 		Where("label = ?label").
@@ -79,6 +116,7 @@ func (v *Volume) BeforeUpdate(db orm.DB) error {
 	return err
 }
 
+// ToKube converts volume to kube-api representation, capacity is rounded up to GiB
 func (v *Volume) ToKube() model.Volume {
 	vol := model.Volume{
 		Name:      v.Label,
@@ -89,18 +127,53 @@ func (v *Volume) ToKube() model.Volume {
 			}
 			return *v.TariffID
 		}(),
-		Capacity:    uint(v.Capacity),
+		Capacity:    v.Capacity.CeilIn(UnitGiB),
 		StorageName: v.StorageName,
 		AccessMode:  v.AccessMode,
+		Status:      string(v.Status),
 	}
 
 	return vol
 }
 
+// ToKubeVolume converts volume to representation passed to kube backends.
+// Exact capacity is kept in CapacityBytes for backends able to provision it.
+func (v *Volume) ToKubeVolume() KubeVolume {
+	return KubeVolume{
+		Volume:        v.ToKube(),
+		CapacityBytes: int64(v.Capacity),
+	}
+}
+
 func (v *Volume) Mask() {
 	v.Resource.Mask()
 	v.StorageName = ""
 	v.AccessMode = ""
+	v.StatusReason = ""
+}
+
+// VolumeResponse is a volume representation returned by API
+//
+// swagger:model
+type VolumeResponse struct {
+	model.Volume
+
+	// Capacity in unit requested by client, fractional if volume size is not a multiple of unit
+	Capacity float64 `json:"capacity"`
+
+	// Cluster volume placed in
+	Cluster string `json:"cluster,omitempty"`
+
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// VolumesResponse is a list of volumes returned by API
+//
+// swagger:model
+type VolumesResponse struct {
+	Volumes []VolumeResponse `json:"volumes"`
+
+	Pagination Pagination `json:"pagination"`
 }
 
 // VolumeCreateRequest is a request object for creating volume
@@ -112,9 +185,9 @@ type VolumeCreateRequest = model.CreateVolume
 //
 // swagger:model
 type DirectVolumeCreateRequest struct {
-	Label    string `json:"label" binding:"required"`
-	Capacity int    `json:"capacity" binding:"gt=0"`
-	Storage  string `json:"storage" binding:"required"`
+	Label    string   `json:"label" binding:"required"`
+	Capacity Quantity `json:"capacity" binding:"gt=0"`
+	Storage  string   `json:"storage" binding:"required"`
 }
 
 // VolumeRenameRequest is a request object for renaming volume
@@ -134,5 +207,5 @@ type VolumeResizeRequest struct {
 //
 // swagger:model
 type AdminVolumeResizeRequest struct {
-	Capacity int `json:"capacity" binding:"gt=0"`
+	Capacity Quantity `json:"capacity" binding:"gt=0"`
 }
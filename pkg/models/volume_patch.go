@@ -0,0 +1,157 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/containerum/kube-client/pkg/model"
+)
+
+// VolumePatch is a JSON merge patch (RFC 7386) of volume mutable fields.
+// Absent fields are not changed.
+//
+// swagger:model
+type VolumePatch struct {
+	// New volume name
+	Label *string `json:"label,omitempty"`
+
+	// Tariff to resize volume to
+	//
+	// swagger:strfmt uuid
+	TariffID *string `json:"tariff_id,omitempty"`
+
+	// New volume capacity without tariff (admins only)
+	Capacity *Quantity `json:"capacity,omitempty"`
+
+	AccessMode *model.PersistentVolumeAccessMode `json:"access_mode,omitempty"`
+
+	// Metadata keys to set, keys with null values are removed. Null metadata removes all keys.
+	Metadata map[string]*string `json:"metadata,omitempty"`
+
+	clearMetadata bool
+}
+
+// volumeLabelRegexp matches DNS-1123 labels used as kubernetes claim names
+var volumeLabelRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+// MaxVolumeMetadataKeys limits number of volume metadata keys
+const MaxVolumeMetadataKeys = 64
+
+// ParseVolumePatch parses and validates merge patch of volume.
+// Unknown fields and nulls for fields which can not be removed are rejected.
+func ParseVolumePatch(data []byte) (VolumePatch, error) {
+	var ret VolumePatch
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil || fields == nil {
+		return ret, fmt.Errorf("patch must be JSON object")
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := fields[name]
+		if string(value) == "null" {
+			if name != "metadata" {
+				return ret, fmt.Errorf("field %q can not be removed", name)
+			}
+			ret.clearMetadata = true
+			continue
+		}
+
+		var target interface{}
+		switch name {
+		case "label":
+			target = &ret.Label
+		case "tariff_id":
+			target = &ret.TariffID
+		case "capacity":
+			target = &ret.Capacity
+		case "access_mode":
+			target = &ret.AccessMode
+		case "metadata":
+			target = &ret.Metadata
+		default:
+			return ret, fmt.Errorf("field %q can not be patched", name)
+		}
+		if err := json.Unmarshal(value, target); err != nil {
+			return ret, fmt.Errorf("field %q: %v", name, err)
+		}
+	}
+
+	return ret, ret.Validate()
+}
+
+// Validate checks values of patch
+func (p VolumePatch) Validate() error {
+	if p.Label != nil && (len(*p.Label) > 63 || !volumeLabelRegexp.MatchString(*p.Label)) {
+		return fmt.Errorf("label must consist of lower case alphanumeric characters or '-' and be at most 63 characters")
+	}
+	if p.TariffID != nil && p.Capacity != nil {
+		return fmt.Errorf("tariff_id and capacity are mutually exclusive")
+	}
+	if p.Capacity != nil && *p.Capacity <= 0 {
+		return fmt.Errorf("capacity must be positive")
+	}
+	if p.AccessMode != nil {
+		switch *p.AccessMode {
+		case model.ReadWriteOnce, model.ReadWriteMany, model.ReadOnlyMany:
+		default:
+			return fmt.Errorf("unknown access mode %q", *p.AccessMode)
+		}
+	}
+	for key := range p.Metadata {
+		if key == "" {
+			return fmt.Errorf("metadata key is empty")
+		}
+	}
+	if len(p.Metadata) > MaxVolumeMetadataKeys {
+		return fmt.Errorf("metadata has more than %d keys", MaxVolumeMetadataKeys)
+	}
+	return nil
+}
+
+// Empty returns true if patch changes nothing
+func (p VolumePatch) Empty() bool {
+	return p.Label == nil && p.TariffID == nil && p.Capacity == nil && p.AccessMode == nil &&
+		p.Metadata == nil && !p.clearMetadata
+}
+
+// ChangesKube returns true if patch changes volume in kubernetes
+func (p VolumePatch) ChangesKube() bool {
+	return p.Label != nil || p.TariffID != nil || p.Capacity != nil || p.AccessMode != nil
+}
+
+// PatchMetadata merges metadata patch into volume metadata
+func (p VolumePatch) PatchMetadata(metadata map[string]string) (map[string]string, error) {
+	if p.clearMetadata {
+		metadata = nil
+	}
+	if p.Metadata == nil {
+		return metadata, nil
+	}
+
+	ret := make(map[string]string, len(metadata)+len(p.Metadata))
+	for k, v := range metadata {
+		ret[k] = v
+	}
+	for k, v := range p.Metadata {
+		if v == nil {
+			delete(ret, k)
+		} else {
+			ret[k] = *v
+		}
+	}
+	if len(ret) > MaxVolumeMetadataKeys {
+		return nil, fmt.Errorf("metadata has more than %d keys", MaxVolumeMetadataKeys)
+	}
+	if len(ret) == 0 {
+		return nil, nil
+	}
+	return ret, nil
+}
@@ -0,0 +1,52 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVolumePatch(t *testing.T) {
+	patch, err := ParseVolumePatch([]byte(`{"label": "data", "capacity": "20Gi", "access_mode": "ReadOnlyMany", "metadata": {"team": "infra", "old": null}}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *patch.Label != "data" || *patch.Capacity != GiBytes(20) || *patch.AccessMode != "ReadOnlyMany" || patch.TariffID != nil {
+		t.Errorf("unexpected patch %+v", patch)
+	}
+	if !patch.ChangesKube() || patch.Empty() {
+		t.Errorf("expected patch to change kube volume")
+	}
+
+	metadata, err := patch.PatchMetadata(map[string]string{"old": "value", "owner": "me"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := map[string]string{"team": "infra", "owner": "me"}; !reflect.DeepEqual(metadata, expected) {
+		t.Errorf("expected metadata %v, got %v", expected, metadata)
+	}
+
+	patch, err = ParseVolumePatch([]byte(`{"metadata": null}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if patch.ChangesKube() || patch.Empty() {
+		t.Errorf("expected metadata only patch, got %+v", patch)
+	}
+	if metadata, _ := patch.PatchMetadata(map[string]string{"a": "b"}); metadata != nil {
+		t.Errorf("expected metadata to be removed, got %v", metadata)
+	}
+
+	for _, invalid := range []string{
+		`[]`,
+		`{"label": null}`,
+		`{"label": "Not_DNS"}`,
+		`{"storage_name": "other"}`,
+		`{"capacity": "10Gi", "tariff_id": "5f9ee0ac-1e4b-4a1e-9a3c-5a0d7a3e7f10"}`,
+		`{"access_mode": "Everything"}`,
+		`{"metadata": {"": "empty"}}`,
+	} {
+		if _, err := ParseVolumePatch([]byte(invalid)); err == nil {
+			t.Errorf("expected error for patch %s", invalid)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package model
+
+import (
+	"time"
+
+	"github.com/containerum/kube-client/pkg/model"
+)
+
+// VolumeStatus describes provisioning state of volume in kubernetes
+//
+// swagger:model
+type VolumeStatus string
+
+const (
+	// Claim created but not bound to persistent volume yet
+	VolumeStatusPending VolumeStatus = "pending"
+	// Claim bound to persistent volume, volume is usable
+	VolumeStatusBound VolumeStatus = "bound"
+	// Persistent volume of claim is lost
+	VolumeStatusLost VolumeStatus = "lost"
+	// Volume exists in database but not in kubernetes
+	VolumeStatusMissing VolumeStatus = "missing"
+)
+
+// VolumeStatusFromPhase converts kubernetes claim phase to volume status
+func VolumeStatusFromPhase(phase string) VolumeStatus {
+	switch phase {
+	case "Bound":
+		return VolumeStatusBound
+	case "Lost":
+		return VolumeStatusLost
+	default:
+		return VolumeStatusPending
+	}
+}
+
+// KubeVolume is a volume as reported by kube backend
+//
+// swagger:model
+type KubeVolume struct {
+	model.Volume
+
+	// Time when claim was bound, if known by backend
+	BoundTime *time.Time `json:"bound_time,omitempty"`
+
+	// Last provisioning failure message of pending claim
+	Reason string `json:"reason,omitempty"`
+
+	// Cluster volume found in, set by volume-manager
+	Cluster string `json:"cluster,omitempty"`
+
+	// Exact capacity in bytes, Capacity is rounded up to GiB.
+	// Zero if backend reports capacity in GiB only.
+	CapacityBytes int64 `json:"capacity_bytes,omitempty"`
+}
+
+// VolumeStatusUpdate is a new status of volume
+type VolumeStatusUpdate struct {
+	Status    VolumeStatus
+	BoundTime *time.Time
+	Reason    string
+}
+
+// StatusUpdate returns status update which should be applied to volume to match kube volume
+// and false if volume status is already actual. Bound time is set to now if backend does not report it.
+func (kv *KubeVolume) StatusUpdate(v *Volume, now time.Time) (VolumeStatusUpdate, bool) {
+	upd := VolumeStatusUpdate{
+		Status:    VolumeStatusFromPhase(kv.Status),
+		BoundTime: v.BoundTime,
+		Reason:    kv.Reason,
+	}
+	if upd.Status == VolumeStatusBound {
+		upd.Reason = ""
+		if upd.BoundTime == nil {
+			upd.BoundTime = kv.BoundTime
+		}
+		if upd.BoundTime == nil {
+			upd.BoundTime = &now
+		}
+	}
+	return upd, upd.differs(v)
+}
+
+// MissingStatusUpdate returns status update for volume not found in kube backend
+func MissingStatusUpdate(v *Volume, reason string) (VolumeStatusUpdate, bool) {
+	upd := VolumeStatusUpdate{
+		Status:    VolumeStatusMissing,
+		BoundTime: v.BoundTime,
+		Reason:    reason,
+	}
+	return upd, upd.differs(v)
+}
+
+func (upd VolumeStatusUpdate) differs(v *Volume) bool {
+	return upd.Status != v.Status ||
+		upd.Reason != v.StatusReason ||
+		(upd.BoundTime == nil) != (v.BoundTime == nil)
+}
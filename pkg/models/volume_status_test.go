@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	kubeModel "github.com/containerum/kube-client/pkg/model"
+)
+
+func TestKubeVolumeStatusUpdate(t *testing.T) {
+	now := time.Now()
+	vol := Volume{Status: VolumeStatusPending}
+
+	pending := KubeVolume{Volume: kubeModel.Volume{Status: "Pending"}, Reason: "ProvisioningFailed: no space"}
+	upd, changed := pending.StatusUpdate(&vol, now)
+	if !changed || upd.Status != VolumeStatusPending || upd.Reason != pending.Reason {
+		t.Fatalf("expected failure reason to be recorded, got %+v (changed %v)", upd, changed)
+	}
+	vol.StatusReason = upd.Reason
+	if _, changed := pending.StatusUpdate(&vol, now); changed {
+		t.Errorf("expected no update for same state")
+	}
+
+	bound := KubeVolume{Volume: kubeModel.Volume{Status: "Bound"}}
+	upd, changed = bound.StatusUpdate(&vol, now)
+	if !changed || upd.Status != VolumeStatusBound || upd.Reason != "" || upd.BoundTime == nil || !upd.BoundTime.Equal(now) {
+		t.Fatalf("expected bound status with bound time, got %+v (changed %v)", upd, changed)
+	}
+	vol.Status, vol.StatusReason, vol.BoundTime = upd.Status, upd.Reason, upd.BoundTime
+
+	upd, changed = bound.StatusUpdate(&vol, now.Add(time.Hour))
+	if changed || !upd.BoundTime.Equal(now) {
+		t.Errorf("expected bound time to be kept, got %+v (changed %v)", upd, changed)
+	}
+
+	upd, changed = MissingStatusUpdate(&vol, "not found")
+	if !changed || upd.Status != VolumeStatusMissing || upd.BoundTime == nil {
+		t.Errorf("unexpected missing volume update %+v (changed %v)", upd, changed)
+	}
+}
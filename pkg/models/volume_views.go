@@ -0,0 +1,63 @@
+package model
+
+// VolumeViews shows volume as stored in database and as reported by kube backend
+//
+// swagger:model
+type VolumeViews struct {
+	Label string `json:"label"`
+
+	// Empty if volume not exists in database
+	DB *Volume `json:"db"`
+
+	// Empty if volume not exists in kube backend or backend request failed
+	Kube *KubeVolume `json:"kube"`
+
+	// Error returned by kube backend, except "not exists"
+	KubeError string `json:"kube_error,omitempty"`
+
+	// Differences between views: "db" or "kube" if volume is missing in one of places,
+	// or names of fields with different values
+	Mismatches []string `json:"mismatches"`
+}
+
+// NamespaceVolumeViews contains views of all namespace volumes found in database or kube backend
+//
+// swagger:model
+type NamespaceVolumeViews struct {
+	Volumes []VolumeViews `json:"volumes"`
+}
+
+// NewVolumeViews compares database and kube views of volume.
+// Kube capacity is compared in bytes if backend reports them, otherwise in GiB.
+func NewVolumeViews(label string, db *Volume, kube *KubeVolume) VolumeViews {
+	ret := VolumeViews{
+		Label:      label,
+		DB:         db,
+		Kube:       kube,
+		Mismatches: make([]string, 0),
+	}
+	switch {
+	case db == nil && kube == nil:
+	case db == nil:
+		ret.Mismatches = append(ret.Mismatches, "db")
+	case kube == nil:
+		ret.Mismatches = append(ret.Mismatches, "kube")
+	default:
+		expected := db.ToKube()
+		if kube.CapacityBytes != 0 && kube.CapacityBytes != int64(db.Capacity) ||
+			kube.CapacityBytes == 0 && expected.Capacity != kube.Capacity {
+			ret.Mismatches = append(ret.Mismatches, "capacity")
+		}
+		if expected.StorageName != kube.StorageName {
+			ret.Mismatches = append(ret.Mismatches, "storage_name")
+		}
+		if expected.AccessMode != kube.AccessMode {
+			ret.Mismatches = append(ret.Mismatches, "access_mode")
+		}
+		// not every backend reports tariff
+		if kube.TariffID != "" && expected.TariffID != kube.TariffID {
+			ret.Mismatches = append(ret.Mismatches, "tariff_id")
+		}
+	}
+	return ret
+}
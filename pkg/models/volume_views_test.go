@@ -0,0 +1,49 @@
+package model
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	kubeModel "github.com/containerum/kube-client/pkg/model"
+)
+
+func TestNewVolumeViews(t *testing.T) {
+	tariff := "tariff"
+	now := time.Now()
+	db := &Volume{
+		Resource:    Resource{Label: "vol", TariffID: &tariff, CreateTime: &now},
+		Capacity:    2 * GiB,
+		StorageName: "ceph",
+		AccessMode:  kubeModel.ReadWriteOnce,
+	}
+	kube := &KubeVolume{Volume: kubeModel.Volume{
+		Name:        "vol",
+		Capacity:    2,
+		StorageName: "ceph",
+		AccessMode:  kubeModel.ReadWriteOnce,
+	}}
+
+	if views := NewVolumeViews("vol", db, kube); len(views.Mismatches) != 0 {
+		t.Errorf("expected no mismatches, got %v", views.Mismatches)
+	}
+
+	kube.Capacity = 1
+	kube.TariffID = "other"
+	if views := NewVolumeViews("vol", db, kube); !reflect.DeepEqual(views.Mismatches, []string{"capacity", "tariff_id"}) {
+		t.Errorf("unexpected mismatches %v", views.Mismatches)
+	}
+
+	// suspension does not change volume in kube
+	db.Suspended = true
+	if views := NewVolumeViews("vol", db, kube); !reflect.DeepEqual(views.Mismatches, []string{"capacity", "tariff_id"}) {
+		t.Errorf("unexpected mismatches %v", views.Mismatches)
+	}
+
+	if views := NewVolumeViews("vol", nil, kube); !reflect.DeepEqual(views.Mismatches, []string{"db"}) {
+		t.Errorf("unexpected mismatches %v", views.Mismatches)
+	}
+	if views := NewVolumeViews("vol", db, nil); !reflect.DeepEqual(views.Mismatches, []string{"kube"}) {
+		t.Errorf("unexpected mismatches %v", views.Mismatches)
+	}
+}
@@ -0,0 +1,216 @@
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// WebhookEvent is a type of event delivered to webhook subscriptions
+type WebhookEvent string
+
+const (
+	VolumeCreatedEvent WebhookEvent = "volume.created"
+	VolumeResizedEvent WebhookEvent = "volume.resized"
+	VolumeDeletedEvent WebhookEvent = "volume.deleted"
+	// Volume found bound in kubernetes again after it was lost or missing
+	VolumeRestoredEvent WebhookEvent = "volume.restored"
+	// Storage fill level reached one of capacity alert thresholds
+	StorageNearFullEvent WebhookEvent = "storage.near_full"
+)
+
+// WebhookEvents lists all supported events
+var WebhookEvents = []WebhookEvent{
+	VolumeCreatedEvent,
+	VolumeResizedEvent,
+	VolumeDeletedEvent,
+	VolumeRestoredEvent,
+	StorageNearFullEvent,
+}
+
+// Headers of webhook requests
+const (
+	WebhookEventHeader     = "X-Webhook-Event"
+	WebhookDeliveryHeader  = "X-Webhook-Delivery"
+	WebhookTimestampHeader = "X-Webhook-Timestamp"
+	WebhookSignatureHeader = "X-Webhook-Signature"
+)
+
+// WebhookSubscription is an endpoint receiving events of selected types
+//
+// swagger:model
+type WebhookSubscription struct {
+	tableName struct{} `sql:"webhook_subscriptions"`
+
+	// swagger:strfmt uuid
+	ID string `sql:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+
+	URL string `sql:"url,notnull" json:"url"`
+
+	Events []WebhookEvent `sql:"events,notnull" pg:",array" json:"events"`
+
+	// Key of HMAC signature of deliveries, returned only on subscription creation
+	Secret string `sql:"secret,notnull" json:"secret,omitempty"`
+
+	CreateTime *time.Time `sql:"create_time,default:now(),notnull" json:"create_time,omitempty"`
+}
+
+// Subscribed returns true if subscription receives event
+func (s WebhookSubscription) Subscribed(event WebhookEvent) bool {
+	for _, e := range s.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookSubscriptionRequest is a request object for creating webhook subscription
+//
+// swagger:model
+type WebhookSubscriptionRequest struct {
+	URL string `json:"url" binding:"required,url"`
+
+	Events []WebhookEvent `json:"events" binding:"required,min=1"`
+
+	// Key of HMAC signature of deliveries, generated if empty
+	Secret string `json:"secret,omitempty"`
+}
+
+// Validate checks that all events are supported
+func (r WebhookSubscriptionRequest) Validate() error {
+	for _, event := range r.Events {
+		known := false
+		for _, e := range WebhookEvents {
+			if e == event {
+				known = true
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown event %q", event)
+		}
+	}
+	return nil
+}
+
+// WebhookDeliveryStatus is a state of webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+// WebhookPayload is a body of webhook request
+//
+// swagger:model
+type WebhookPayload struct {
+	// Event id, the same for deliveries of event to all subscriptions
+	//
+	// swagger:strfmt uuid
+	ID string `json:"id"`
+
+	Event WebhookEvent `json:"event"`
+
+	Time time.Time `json:"time"`
+
+	Volume *VolumeResponse `json:"volume,omitempty"`
+
+	// Volume before change for "volume.resized" event
+	PreviousVolume *VolumeResponse `json:"previous_volume,omitempty"`
+
+	// Capacity alert for "storage.near_full" event
+	Alert *CapacityAlert `json:"alert,omitempty"`
+}
+
+// WebhookDelivery is a log record of event delivery to subscription
+//
+// swagger:model
+type WebhookDelivery struct {
+	tableName struct{} `sql:"webhook_deliveries"`
+
+	// swagger:strfmt uuid
+	ID string `sql:"id,pk,type:uuid,default:uuid_generate_v4()" json:"id"`
+
+	// swagger:strfmt uuid
+	SubscriptionID string `sql:"subscription_id,type:uuid,notnull" json:"subscription_id"`
+
+	Event WebhookEvent `sql:"event,notnull" json:"event"`
+
+	Payload WebhookPayload `sql:"payload,type:jsonb,notnull" json:"payload"`
+
+	Status WebhookDeliveryStatus `sql:"status,notnull" json:"status"`
+
+	Attempts int `sql:"attempts,notnull" json:"attempts"`
+
+	// HTTP status of the last attempt
+	ResponseStatus int `sql:"response_status,notnull" json:"response_status,omitempty"`
+
+	// Error of the last failed attempt
+	Error string `sql:"error,notnull" json:"error,omitempty"`
+
+	CreateTime *time.Time `sql:"create_time,default:now(),notnull" json:"create_time,omitempty"`
+
+	// Time of the next attempt, empty when delivery is finished
+	NextAttemptTime *time.Time `sql:"next_attempt_time" json:"next_attempt_time,omitempty"`
+
+	DeliveredTime *time.Time `sql:"delivered_time" json:"delivered_time,omitempty"`
+}
+
+// Webhook retry parameters
+const (
+	WebhookMaxAttempts    = 8
+	webhookBackoffBase    = 30 * time.Second
+	webhookBackoffCeiling = 2 * time.Hour
+)
+
+// WebhookBackoff returns delay before next attempt after failed attempts
+func WebhookBackoff(attempts int) time.Duration {
+	delay := webhookBackoffBase
+	for i := 1; i < attempts && delay < webhookBackoffCeiling; i++ {
+		delay *= 2
+	}
+	if delay > webhookBackoffCeiling {
+		delay = webhookBackoffCeiling
+	}
+	return delay
+}
+
+// AttemptFailed records failed attempt and schedules retry or marks delivery failed when attempts are exhausted
+func (d *WebhookDelivery) AttemptFailed(now time.Time, responseStatus int, err error) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.Error = err.Error()
+	if d.Attempts >= WebhookMaxAttempts {
+		d.Status = WebhookDeliveryFailed
+		d.NextAttemptTime = nil
+		return
+	}
+	d.Status = WebhookDeliveryPending
+	next := now.Add(WebhookBackoff(d.Attempts))
+	d.NextAttemptTime = &next
+}
+
+// AttemptSucceeded records successful attempt
+func (d *WebhookDelivery) AttemptSucceeded(now time.Time, responseStatus int) {
+	d.Attempts++
+	d.ResponseStatus = responseStatus
+	d.Error = ""
+	d.Status = WebhookDeliveryDelivered
+	d.NextAttemptTime = nil
+	d.DeliveredTime = &now
+}
+
+// SignWebhook returns signature of webhook request body sent at timestamp (unix seconds).
+// Signature is "sha256=" followed by hex HMAC-SHA256 of "<timestamp>.<body>" keyed with subscription secret.
+func SignWebhook(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,55 @@
+package model
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSignWebhook(t *testing.T) {
+	// echo -n '1500000000.{"event":"volume.created"}' | openssl dgst -sha256 -hmac secret
+	const expected = "sha256=f2f608cc1408ec90f321c170890728b753820535c35263ef75c3dbd38e661303"
+	if sig := SignWebhook("secret", 1500000000, []byte(`{"event":"volume.created"}`)); sig != expected {
+		t.Errorf("expected signature %q, got %q", expected, sig)
+	}
+}
+
+func TestWebhookBackoff(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  30 * time.Second,
+		2:  time.Minute,
+		3:  2 * time.Minute,
+		8:  64 * time.Minute,
+		9:  2 * time.Hour,
+		20: 2 * time.Hour,
+	}
+	for attempts, expected := range cases {
+		if delay := WebhookBackoff(attempts); delay != expected {
+			t.Errorf("attempts %d: expected %v, got %v", attempts, expected, delay)
+		}
+	}
+}
+
+func TestWebhookDeliveryAttempts(t *testing.T) {
+	now := time.Date(2018, 1, 1, 0, 0, 0, 0, time.UTC)
+	var d WebhookDelivery
+
+	d.AttemptFailed(now, 500, errors.New("server error"))
+	if d.Status != WebhookDeliveryPending || d.NextAttemptTime == nil || !d.NextAttemptTime.Equal(now.Add(30*time.Second)) {
+		t.Fatalf("expected retry in 30s, got status %s, next attempt %v", d.Status, d.NextAttemptTime)
+	}
+
+	for d.Status == WebhookDeliveryPending {
+		d.AttemptFailed(now, 0, errors.New("timeout"))
+	}
+	if d.Status != WebhookDeliveryFailed || d.Attempts != WebhookMaxAttempts || d.NextAttemptTime != nil || d.Error != "timeout" {
+		t.Errorf("expected failed delivery after %d attempts, got %+v", WebhookMaxAttempts, d)
+	}
+
+	d = WebhookDelivery{}
+	d.AttemptFailed(now, 500, errors.New("server error"))
+	d.AttemptSucceeded(now, 204)
+	if d.Status != WebhookDeliveryDelivered || d.Attempts != 2 || d.Error != "" || d.NextAttemptTime != nil || d.DeliveredTime == nil {
+		t.Errorf("expected delivered delivery, got %+v", d)
+	}
+}
@@ -0,0 +1,92 @@
+package router
+
+import (
+	"net/http"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/containerum/utils/httputil"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type billingDebugHandlers struct {
+	tv   *TranslateValidate
+	acts server.BillingDebugActions
+}
+
+func (bh *billingDebugHandlers) getFakeBillingStateHandler(ctx *gin.Context) {
+	state, err := bh.acts.GetFakeBillingState(ctx.Request.Context())
+	if err != nil {
+		ctx.AbortWithStatusJSON(bh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, state)
+}
+
+func (bh *billingDebugHandlers) setFakeBillingFailuresHandler(ctx *gin.Context) {
+	var req []model.BillingFailure
+	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
+		ctx.AbortWithStatusJSON(bh.tv.BadRequest(ctx, err))
+		return
+	}
+	state, err := bh.acts.SetFakeBillingFailures(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.AbortWithStatusJSON(bh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, state)
+}
+
+// SetupBillingDebugHandlers registers endpoints of in-process fake billing. They respond with 404 if fake billing is not used.
+func (r *Router) SetupBillingDebugHandlers(acts server.BillingDebugActions) {
+	handlers := &billingDebugHandlers{tv: r.tv, acts: acts}
+
+	group := r.engine.Group("/debug/billing", httputil.RequireAdminRole(errors.ErrAdminRequired))
+
+	// swagger:operation GET /debug/billing/subscriptions Debug GetFakeBillingState
+	//
+	// Get subscriptions and simulated failures of fake billing (admin only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	// responses:
+	//   '200':
+	//     description: fake billing state
+	//     schema:
+	//       $ref: '#/definitions/FakeBillingState'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.GET("/subscriptions", handlers.getFakeBillingStateHandler)
+
+	// swagger:operation PUT /debug/billing/failures Debug SetFakeBillingFailures
+	//
+	// Replace failures simulated by fake billing (admin only).
+	// Failures are checked in order, first one matching called method is returned.
+	// Empty list disables failures.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      type: array
+	//      items:
+	//        $ref: '#/definitions/BillingFailure'
+	// responses:
+	//   '200':
+	//     description: fake billing state
+	//     schema:
+	//       $ref: '#/definitions/FakeBillingState'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.PUT("/failures", handlers.setFakeBillingFailuresHandler)
+}
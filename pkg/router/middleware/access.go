@@ -2,6 +2,7 @@ package middleware
 
 import (
 	volErrors "git.containerum.net/ch/volume-manager/pkg/errors"
+	"github.com/containerum/cherry"
 	"github.com/containerum/cherry/adaptors/gonic"
 	kubeModel "github.com/containerum/kube-client/pkg/model"
 	headers "github.com/containerum/utils/httputil"
@@ -53,26 +54,51 @@ func WriteAccess(ctx *gin.Context) {
 }
 
 func CheckAccess(ctx *gin.Context, level []kubeModel.AccessLevel) {
-	ns := ctx.Param("ns_id")
 	if GetHeader(ctx, headers.UserRoleXHeader) == RoleUser {
-		var userNsData *kubeModel.UserHeaderData
-		nsList := ctx.MustGet(UserNamespaces).(UserHeaderDataMap)
-		for _, n := range nsList {
-			if ns == n.ID {
-				userNsData = &n
-				break
-			}
+		if err := namespaceAccess(ctx.MustGet(UserNamespaces).(UserHeaderDataMap), ctx.Param("ns_id"), level); err != nil {
+			gonic.Gonic(err, ctx)
 		}
-		if userNsData != nil {
-			if ok := containsAccess(userNsData.Access, level...); ok {
-				return
-			}
-			gonic.Gonic(volErrors.ErrRequestValidationFailed().AddDetailF("access error"), ctx)
-			return
+	}
+}
+
+// ReadNamespaceAccess checks that user with namespaces from X-User-Namespace header can read namespace.
+// It is used where namespace is not a gin route parameter.
+func ReadNamespaceAccess(nsList UserHeaderDataMap, ns string) error {
+	return accessError(nsList, ns, readLevels)
+}
+
+// DeleteNamespaceAccess checks that user with namespaces from X-User-Namespace header can delete in namespace
+func DeleteNamespaceAccess(nsList UserHeaderDataMap, ns string) error {
+	return accessError(nsList, ns, deleteLevels)
+}
+
+// WriteNamespaceAccess checks that user with namespaces from X-User-Namespace header can write to namespace
+func WriteNamespaceAccess(nsList UserHeaderDataMap, ns string) error {
+	return accessError(nsList, ns, writeLevels)
+}
+
+func accessError(nsList UserHeaderDataMap, ns string, level []kubeModel.AccessLevel) error {
+	if err := namespaceAccess(nsList, ns, level); err != nil {
+		return err
+	}
+	return nil
+}
+
+func namespaceAccess(nsList UserHeaderDataMap, ns string, level []kubeModel.AccessLevel) *cherry.Err {
+	var userNsData *kubeModel.UserHeaderData
+	for _, n := range nsList {
+		if ns == n.ID {
+			userNsData = &n
+			break
 		}
-		gonic.Gonic(volErrors.ErrResourceNotExists().AddDetails("namespace is not found"), ctx)
-		return
 	}
+	if userNsData != nil {
+		if ok := containsAccess(userNsData.Access, level...); ok {
+			return nil
+		}
+		return volErrors.ErrRequestValidationFailed().AddDetailF("access error")
+	}
+	return volErrors.ErrResourceNotExists().AddDetails("namespace is not found")
 }
 
 func containsAccess(access kubeModel.AccessLevel, in ...kubeModel.AccessLevel) bool {
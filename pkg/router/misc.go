@@ -5,6 +5,13 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/containerum/cherry/adaptors/gonic"
+	"github.com/gin-gonic/gin"
 )
 
 func getFilters(values url.Values) []string {
@@ -15,19 +22,110 @@ func getFilters(values url.Values) []string {
 	return strings.Split(q, ",")
 }
 
-func getPaginationParams(values url.Values) (page, perPage int, err error) {
-	if values.Get("per_page") == "" {
-		return 0, 0, nil
+func getSort(values url.Values) []string {
+	q := values.Get("sort")
+	if len(q) == 0 {
+		return nil
 	}
-	page, err = strconv.Atoi(values.Get("page"))
-	if err != nil {
-		err = fmt.Errorf("page number not integer")
+	return strings.Split(q, ",")
+}
+
+// getPageRequest reads "page", "per_page" and "cursor" parameters.
+// List is not paginated if none of them present, per page limit defaults to model.DefaultPerPage otherwise.
+func getPageRequest(values url.Values) (req model.PageRequest, err error) {
+	if req.Page, err = getIntParam(values, "page"); err != nil {
 		return
 	}
-	perPage, err = strconv.Atoi(values.Get("per_page"))
-	if err != nil {
-		err = fmt.Errorf("per page limit not integer")
+	if req.PerPage, err = getIntParam(values, "per_page"); err != nil {
 		return
 	}
+	req.Cursor = values.Get("cursor")
+	if req.PerPage == 0 && (req.Page != 0 || req.Cursor != "") {
+		req.PerPage = model.DefaultPerPage
+	}
+	err = req.Validate()
 	return
 }
+
+// setPaginationHeaders describes returned page in "X-Total-Count" and "Link" (RFC 8288) headers
+func setPaginationHeaders(ctx *gin.Context, page model.Pagination) {
+	ctx.Header("X-Total-Count", strconv.Itoa(page.Total))
+	if page.PerPage == 0 {
+		return
+	}
+
+	var links []string
+	link := func(rel string, set map[string]string) {
+		u := *ctx.Request.URL
+		query := u.Query()
+		query.Del("page")
+		query.Del("cursor")
+		for k, v := range set {
+			query.Set(k, v)
+		}
+		query.Set("per_page", strconv.Itoa(page.PerPage))
+		u.RawQuery = query.Encode()
+		links = append(links, fmt.Sprintf("<%s>; rel=%q", u.RequestURI(), rel))
+	}
+
+	switch {
+	case page.NextCursor != "":
+		link("next", map[string]string{"cursor": page.NextCursor})
+	case page.HasNext():
+		link("next", map[string]string{"page": strconv.Itoa(page.Page + 1)})
+	}
+	if page.Page > 1 {
+		link("prev", map[string]string{"page": strconv.Itoa(page.Page - 1)})
+	}
+	link("first", map[string]string{"page": "1"})
+	if page.Page > 0 {
+		link("last", map[string]string{"page": strconv.Itoa(page.LastPage())})
+	}
+	ctx.Header("Link", strings.Join(links, ", "))
+}
+
+func getBoolParam(values url.Values, name string) (bool, error) {
+	value := values.Get(name)
+	if value == "" {
+		return false, nil
+	}
+	ret, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("%s is not boolean", name)
+	}
+	return ret, nil
+}
+
+func getIntParam(values url.Values, name string) (int, error) {
+	value := values.Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	ret, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not integer", name)
+	}
+	return ret, nil
+}
+
+func getTimeParam(values url.Values, name string, defaultValue time.Time) (time.Time, error) {
+	value := values.Get(name)
+	if value == "" {
+		return defaultValue, nil
+	}
+	ret, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s is not RFC3339 time", name)
+	}
+	return ret, nil
+}
+
+// responseUnit saves unit requested in "units" query parameter to request context
+func responseUnit(ctx *gin.Context) {
+	unit, err := model.ParseUnit(ctx.Query("units"))
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+	ctx.Request = ctx.Request.WithContext(server.WithResponseUnit(ctx.Request.Context(), unit))
+}
@@ -5,7 +5,9 @@ import (
 
 	"git.containerum.net/ch/volume-manager/pkg/errors"
 	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/router/middleware"
 	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/containerum/cherry/adaptors/gonic"
 	kubeClientModel "github.com/containerum/kube-client/pkg/model"
 	"github.com/containerum/utils/httputil"
 	"github.com/gin-gonic/gin"
@@ -33,7 +35,13 @@ func (sh *storageHandlers) createStorageHandler(ctx *gin.Context) {
 }
 
 func (sh *storageHandlers) importStoragesHandler(ctx *gin.Context) {
-	var req []string
+	dryRun, err := getBoolParam(ctx.Request.URL.Query(), "dry_run")
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	var req []model.StorageImportRequest
 	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
 		ctx.AbortWithStatusJSON(sh.tv.BadRequest(ctx, err))
 		return
@@ -45,16 +53,17 @@ func (sh *storageHandlers) importStoragesHandler(ctx *gin.Context) {
 	}
 
 	for _, r := range req {
-		store := model.Storage{
-			Name: r,
-			Size: 100,
-		}
-
-		if err := sh.acts.CreateStorage(ctx.Request.Context(), store); err != nil {
+		updated, err := sh.acts.ImportStorage(ctx.Request.Context(), r, dryRun)
+		switch {
+		case err != nil:
 			logrus.Warn(err)
-			resp.ImportFailed(r, "", err.Error())
-		} else {
-			resp.ImportSuccessful(r, "")
+			resp.ImportFailed(r.Name, "", err.Error())
+		case dryRun && updated:
+			resp.Imported = append(resp.Imported, kubeClientModel.ImportResult{Name: r.Name, Message: "storage will be updated"})
+		case dryRun:
+			resp.Imported = append(resp.Imported, kubeClientModel.ImportResult{Name: r.Name, Message: "storage will be created"})
+		default:
+			resp.ImportSuccessful(r.Name, "")
 		}
 	}
 
@@ -62,15 +71,60 @@ func (sh *storageHandlers) importStoragesHandler(ctx *gin.Context) {
 }
 
 func (sh *storageHandlers) getStoragesHandler(ctx *gin.Context) {
-	storages, err := sh.acts.GetStorages(ctx.Request.Context())
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	storages, pagination, err := sh.acts.GetStorages(ctx.Request.Context(), page)
 	if err != nil {
 		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
 		return
 	}
 
+	setPaginationHeaders(ctx, pagination)
 	ctx.JSON(http.StatusOK, storages)
 }
 
+func (sh *storageHandlers) getNamespaceStoragesHandler(ctx *gin.Context) {
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	storages, pagination, err := sh.acts.GetNamespaceStorages(ctx.Request.Context(), ctx.Param("ns_id"), page)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	for i := range storages {
+		httputil.MaskForNonAdmin(ctx, &storages[i])
+	}
+
+	setPaginationHeaders(ctx, pagination)
+	ctx.JSON(http.StatusOK, storages)
+}
+
+func (sh *storageHandlers) getStorageHandler(ctx *gin.Context) {
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	storage, err := sh.acts.GetStorage(ctx.Request.Context(), ctx.Param("name"), page)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	setPaginationHeaders(ctx, storage.VolumesPagination)
+	ctx.JSON(http.StatusOK, storage)
+}
+
 func (sh *storageHandlers) updateStorageHandler(ctx *gin.Context) {
 	var req model.UpdateStorageRequest
 	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
@@ -92,6 +146,54 @@ func (sh *storageHandlers) deleteStorageHandler(ctx *gin.Context) {
 	ctx.Status(http.StatusAccepted)
 }
 
+func (sh *storageHandlers) recalculateStoragesHandler(ctx *gin.Context) {
+	dryRun, err := getBoolParam(ctx.Request.URL.Query(), "dry_run")
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	report, err := sh.acts.RecalculateStoragesUsage(ctx.Request.Context(), dryRun)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (sh *storageHandlers) syncStoragesHandler(ctx *gin.Context) {
+	dryRun, err := getBoolParam(ctx.Request.URL.Query(), "dry_run")
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	report, err := sh.acts.SyncStorages(ctx.Request.Context(), dryRun)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (sh *storageHandlers) getStorageForecastHandler(ctx *gin.Context) {
+	days, err := getIntParam(ctx.Request.URL.Query(), "days")
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	forecast, err := sh.acts.GetStorageForecast(ctx.Request.Context(), ctx.Param("name"), days)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, forecast)
+}
+
 func (r *Router) SetupStorageHandlers(acts server.StorageActions) {
 	handlers := &storageHandlers{tv: r.tv, acts: acts}
 
@@ -127,9 +229,20 @@ func (r *Router) SetupStorageHandlers(acts server.StorageActions) {
 	//  - $ref: '#/parameters/UserIDHeader'
 	//  - $ref: '#/parameters/UserRoleHeader'
 	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
 	// responses:
 	//   '200':
 	//     description: storages list
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
 	//     schema:
 	//       type: array
 	//       items:
@@ -138,6 +251,39 @@ func (r *Router) SetupStorageHandlers(acts server.StorageActions) {
 	//     $ref: '#/responses/error'
 	group.GET("", handlers.getStoragesHandler)
 
+	// swagger:operation GET /storages/{name} Storages GetStorage
+	//
+	// Get storage with its live volumes and per-namespace usage.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - name: name
+	//    in: path
+	//    type: string
+	//    required: true
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
+	// responses:
+	//   '200':
+	//     description: storage details
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
+	//     schema:
+	//       $ref: '#/definitions/StorageDetails'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.GET("/:name", handlers.getStorageHandler)
+
 	// swagger:operation PUT /storages/{name} Storages UpdateStorage
 	//
 	// Update storage.
@@ -183,14 +329,58 @@ func (r *Router) SetupStorageHandlers(acts server.StorageActions) {
 	//     $ref: '#/responses/error'
 	group.DELETE("/:name", handlers.deleteStorageHandler)
 
+	// swagger:operation GET /namespaces/{ns_id}/storages Storages GetNamespaceStorages
+	//
+	// Get storages available for namespace volumes.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - $ref: '#/parameters/NamespaceID'
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
+	// responses:
+	//   '200':
+	//     description: storages list
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
+	//     schema:
+	//       type: array
+	//       items:
+	//         $ref: '#/definitions/Storage'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.GET("/namespaces/:ns_id/storages", middleware.ReadAccess, handlers.getNamespaceStoragesHandler)
+
 	// swagger:operation POST /import/storages Storages ImportStorages
 	//
 	// Import storages.
+	// Existing storages are updated. Storage may be provided as plain name, in this case default size used.
 	//
 	// ---
 	// parameters:
 	//  - $ref: '#/parameters/UserIDHeader'
 	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: dry_run
+	//    in: query
+	//    type: boolean
+	//    required: false
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      type: array
+	//      items:
+	//        $ref: '#/definitions/StorageImportRequest'
 	// responses:
 	//   '202':
 	//     description: storages imported
@@ -199,4 +389,75 @@ func (r *Router) SetupStorageHandlers(acts server.StorageActions) {
 	//   default:
 	//     $ref: '#/responses/error'
 	r.engine.POST("/import/storages", handlers.importStoragesHandler)
+
+	// swagger:operation POST /recalculate/storages Storages RecalculateStorages
+	//
+	// Recalculate storages used capacity from live volumes (admin only).
+	// In dry run mode only discrepancies are reported.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: dry_run
+	//    in: query
+	//    type: boolean
+	//    required: false
+	// responses:
+	//   '200':
+	//     description: storages usage report
+	//     schema:
+	//       $ref: '#/definitions/StorageUsageReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.POST("/recalculate/storages", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.recalculateStoragesHandler)
+
+	// swagger:operation POST /sync/storages Storages SyncStorages
+	//
+	// Synchronize storages with storage classes from kube backend (admin only).
+	// Storages are created, updated or deleted to match allowed storage classes.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: dry_run
+	//    in: query
+	//    type: boolean
+	//    required: false
+	// responses:
+	//   '200':
+	//     description: storages synchronization report
+	//     schema:
+	//       $ref: '#/definitions/StorageSyncReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.POST("/sync/storages", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.syncStoragesHandler)
+
+	// swagger:operation GET /admin/storages/{name}/forecast Storages GetStorageForecast
+	//
+	// Project when storage becomes full from daily usage history (admin only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - name: name
+	//    in: path
+	//    type: string
+	//    required: true
+	//  - name: days
+	//    in: query
+	//    type: integer
+	//    required: false
+	//    description: number of days of history used for projection, 90 by default
+	// responses:
+	//   '200':
+	//     description: storage forecast
+	//     schema:
+	//       $ref: '#/definitions/StorageForecast'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.GET("/admin/storages/:name/forecast", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.getStorageForecastHandler)
 }
@@ -0,0 +1,184 @@
+package router
+
+import (
+	"net/http"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/containerum/cherry/adaptors/gonic"
+	"github.com/containerum/utils/httputil"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type suspensionHandlers struct {
+	tv   *TranslateValidate
+	acts server.SuspensionActions
+}
+
+func (sh *suspensionHandlers) getSuspensionsHandler(ctx *gin.Context) {
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	ret, pagination, err := sh.acts.GetSuspensions(ctx.Request.Context(), page)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	setPaginationHeaders(ctx, pagination)
+	ctx.JSON(http.StatusOK, ret)
+}
+
+func (sh *suspensionHandlers) suspendHandler(ctx *gin.Context) {
+	var req model.SuspensionRequest
+	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.BadRequest(ctx, err))
+		return
+	}
+	report, err := sh.acts.Suspend(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (sh *suspensionHandlers) resumeHandler(ctx *gin.Context) {
+	report, err := sh.acts.Resume(ctx.Request.Context(), model.SuspensionKind(ctx.Param("kind")), ctx.Param("id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (sh *suspensionHandlers) billingAccountEventHandler(ctx *gin.Context) {
+	var req model.BillingAccountEvent
+	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.BadRequest(ctx, err))
+		return
+	}
+	report, err := sh.acts.HandleBillingAccountEvent(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.AbortWithStatusJSON(sh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (r *Router) SetupSuspensionHandlers(acts server.SuspensionActions) {
+	handlers := &suspensionHandlers{tv: r.tv, acts: acts}
+
+	group := r.engine.Group("/admin/suspensions", httputil.RequireAdminRole(errors.ErrAdminRequired))
+
+	// swagger:operation GET /admin/suspensions Suspensions GetSuspensions
+	//
+	// Get suspended users and namespaces (admin only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
+	// responses:
+	//   '200':
+	//     description: suspensions list
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
+	//     schema:
+	//       type: array
+	//       items:
+	//         $ref: '#/definitions/Suspension'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.GET("", handlers.getSuspensionsHandler)
+
+	// swagger:operation POST /admin/suspensions Suspensions Suspend
+	//
+	// Suspend user or namespace (admin only).
+	// Creating and resizing volumes is rejected. Volumes are detached from workloads in kube until resumed.
+	// If kube backend of any volume can not suspend volumes (kube-api), 501 is returned and nothing is suspended.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      $ref: '#/definitions/SuspensionRequest'
+	// responses:
+	//   '200':
+	//     description: suspended volumes
+	//     schema:
+	//       $ref: '#/definitions/SuspensionReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.POST("", handlers.suspendHandler)
+
+	// swagger:operation DELETE /admin/suspensions/{kind}/{id} Suspensions Resume
+	//
+	// Resume suspended user or namespace (admin only).
+	// Volumes stay suspended if their owner or namespace is still suspended.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: kind
+	//    in: path
+	//    type: string
+	//    enum: [user, namespace]
+	//    required: true
+	//  - name: id
+	//    in: path
+	//    type: string
+	//    required: true
+	// responses:
+	//   '200':
+	//     description: resumed volumes
+	//     schema:
+	//       $ref: '#/definitions/SuspensionReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.DELETE("/:kind/:id", handlers.resumeHandler)
+
+	// swagger:operation POST /billing/events Suspensions BillingAccountEvent
+	//
+	// Billing callback which suspends blocked accounts and resumes unblocked ones (admin only).
+	// Suspension is not supported and 501 is returned if kube backend of any account volume can not suspend volumes.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      $ref: '#/definitions/BillingAccountEvent'
+	// responses:
+	//   '200':
+	//     description: suspended or resumed volumes
+	//     schema:
+	//       $ref: '#/definitions/SuspensionReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.POST("/billing/events", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.billingAccountEventHandler)
+}
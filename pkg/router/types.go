@@ -1,6 +1,7 @@
 package router
 
 import (
+	"context"
 	"net/textproto"
 
 	"git.containerum.net/ch/volume-manager/pkg/errors"
@@ -30,18 +31,24 @@ func (tv *TranslateValidate) HandleError(err error) (int, *cherry.Err) {
 }
 
 func (tv *TranslateValidate) BadRequest(ctx *gin.Context, err error) (int, *cherry.Err) {
+	ret := tv.ValidationError(ctx.Request.Context(), err)
+	return ret.StatusHTTP, ret
+}
+
+// ValidationError converts request validation error to cherry error translated to languages accepted by client
+func (tv *TranslateValidate) ValidationError(ctx context.Context, err error) *cherry.Err {
 	if validationErr, ok := err.(validator.ValidationErrors); ok {
 		ret := errors.ErrRequestValidationFailed()
 		for _, fieldErr := range validationErr {
 			if fieldErr == nil {
 				continue
 			}
-			t, _ := tv.FindTranslator(httputil.GetAcceptedLanguages(ctx.Request.Context())...)
+			t, _ := tv.FindTranslator(httputil.GetAcceptedLanguages(ctx)...)
 			ret.AddDetailF("Field %s: %s", fieldErr.Namespace(), fieldErr.Translate(t))
 		}
-		return ret.StatusHTTP, ret
+		return ret
 	}
-	return errors.ErrRequestValidationFailed().StatusHTTP, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	return errors.ErrRequestValidationFailed().AddDetailsErr(err)
 }
 
 func (tv *TranslateValidate) ValidateHeaders(headerTagMap map[string]string) gin.HandlerFunc {
@@ -79,10 +86,32 @@ type Router struct {
 	tv     *TranslateValidate
 }
 
-func NewRouter(engine gin.IRouter, status *model.ServiceStatus, tv *TranslateValidate) *Router {
+// StatusReporter provides dynamic details of service status
+type StatusReporter interface {
+	StatusDetails() map[string]string
+}
+
+func serviceStatus(status *model.ServiceStatus, reporter StatusReporter) gin.HandlerFunc {
+	if reporter == nil {
+		return httputil.ServiceStatus(status)
+	}
+	return func(ctx *gin.Context) {
+		current := *status
+		current.Details = make(map[string]string)
+		for k, v := range status.Details {
+			current.Details[k] = v
+		}
+		for k, v := range reporter.StatusDetails() {
+			current.Details[k] = v
+		}
+		httputil.ServiceStatus(&current)(ctx)
+	}
+}
+
+func NewRouter(engine gin.IRouter, status *model.ServiceStatus, tv *TranslateValidate, reporter StatusReporter) *Router {
 	engine.StaticFS("/static", static.HTTP)
 
-	engine.GET("/status", httputil.ServiceStatus(status))
+	engine.GET("/status", serviceStatus(status, reporter))
 
 	ret := &Router{
 		engine: engine,
@@ -97,5 +126,6 @@ func NewRouter(engine gin.IRouter, status *model.ServiceStatus, tv *TranslateVal
 	}))
 	ret.engine.Use(httputil.SubstituteUserMiddleware(tv.Validate, tv.UniversalTranslator, errors.ErrRequestValidationFailed))
 	ret.engine.Use(middleware.RequiredUserHeaders())
+	ret.engine.Use(responseUnit)
 	return ret
 }
@@ -1,7 +1,11 @@
 package router
 
 import (
+	"fmt"
+	"io/ioutil"
+	"mime"
 	"net/http"
+	"time"
 
 	kubeClientModel "github.com/containerum/kube-client/pkg/model"
 
@@ -16,6 +20,9 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// mergePatchContentType is a media type of JSON merge patch (RFC 7386)
+const mergePatchContentType = "application/merge-patch+json"
+
 type volumeHandlers struct {
 	tv   *TranslateValidate
 	acts server.VolumeActions
@@ -73,6 +80,21 @@ func (vh *volumeHandlers) createVolumeHandler(ctx *gin.Context) {
 	ctx.Status(http.StatusCreated)
 }
 
+func (vh *volumeHandlers) quoteVolumeHandler(ctx *gin.Context) {
+	var req model.VolumeQuoteRequest
+	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.BadRequest(ctx, err))
+		return
+	}
+	quote, err := vh.acts.QuoteVolume(ctx.Request.Context(), ctx.Param("ns_id"), req)
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, quote)
+}
+
 func (vh *volumeHandlers) getVolumeHandler(ctx *gin.Context) {
 	ret, err := vh.acts.GetVolume(ctx.Request.Context(), ctx.Param("ns_id"), ctx.Param("label"))
 	if err != nil {
@@ -86,7 +108,13 @@ func (vh *volumeHandlers) getVolumeHandler(ctx *gin.Context) {
 }
 
 func (vh *volumeHandlers) getNamespaceVolumesHandler(ctx *gin.Context) {
-	ret, err := vh.acts.GetNamespaceVolumes(ctx.Request.Context(), ctx.Param("ns_id"))
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	ret, err := vh.acts.GetNamespaceVolumes(ctx.Request.Context(), ctx.Param("ns_id"), page)
 
 	if err != nil {
 		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
@@ -97,11 +125,18 @@ func (vh *volumeHandlers) getNamespaceVolumesHandler(ctx *gin.Context) {
 		httputil.MaskForNonAdmin(ctx, &ret.Volumes[i])
 	}
 
+	setPaginationHeaders(ctx, ret.Pagination)
 	ctx.JSON(http.StatusOK, ret)
 }
 
 func (vh *volumeHandlers) getUserVolumesHandler(ctx *gin.Context) {
-	ret, err := vh.acts.GetUserVolumes(ctx.Request.Context())
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	ret, err := vh.acts.GetUserVolumes(ctx.Request.Context(), page)
 
 	if err != nil {
 		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
@@ -112,22 +147,24 @@ func (vh *volumeHandlers) getUserVolumesHandler(ctx *gin.Context) {
 		httputil.MaskForNonAdmin(ctx, &ret.Volumes[i])
 	}
 
+	setPaginationHeaders(ctx, ret.Pagination)
 	ctx.JSON(http.StatusOK, ret)
 }
 
 func (vh *volumeHandlers) getAllVolumesHandler(ctx *gin.Context) {
-	page, perPage, err := getPaginationParams(ctx.Request.URL.Query())
+	page, err := getPageRequest(ctx.Request.URL.Query())
 	if err != nil {
 		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
 		return
 	}
 
-	ret, err := vh.acts.GetAllVolumes(ctx.Request.Context(), page, perPage, getFilters(ctx.Request.URL.Query())...)
+	ret, err := vh.acts.GetAllVolumes(ctx.Request.Context(), page, getSort(ctx.Request.URL.Query()), getFilters(ctx.Request.URL.Query())...)
 	if err != nil {
 		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
 		return
 	}
 
+	setPaginationHeaders(ctx, ret.Pagination)
 	ctx.JSON(http.StatusOK, ret)
 }
 
@@ -172,6 +209,33 @@ func (vh *volumeHandlers) resizeVolumeHandler(ctx *gin.Context) {
 	ctx.Status(http.StatusOK)
 }
 
+func (vh *volumeHandlers) patchVolumeHandler(ctx *gin.Context) {
+	if mediaType, _, _ := mime.ParseMediaType(ctx.GetHeader("Content-Type")); mediaType != mergePatchContentType && mediaType != binding.MIMEJSON {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailF("content type must be %s", mergePatchContentType), ctx)
+		return
+	}
+	body, err := ioutil.ReadAll(ctx.Request.Body)
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.BadRequest(ctx, err))
+		return
+	}
+	patch, err := model.ParseVolumePatch(body)
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	ret, err := vh.acts.PatchVolume(ctx.Request.Context(), ctx.Param("ns_id"), ctx.Param("label"), patch)
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	httputil.MaskForNonAdmin(ctx, &ret)
+
+	ctx.JSON(http.StatusOK, ret)
+}
+
 func (vh *volumeHandlers) adminResizeVolumeHandler(ctx *gin.Context) {
 	var req model.AdminVolumeResizeRequest
 	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
@@ -186,6 +250,103 @@ func (vh *volumeHandlers) adminResizeVolumeHandler(ctx *gin.Context) {
 	ctx.Status(http.StatusOK)
 }
 
+func (vh *volumeHandlers) getVolumeViewsHandler(ctx *gin.Context) {
+	ret, err := vh.acts.GetVolumeViews(ctx.Request.Context(), ctx.Param("ns_id"), ctx.Param("label"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ret)
+}
+
+func (vh *volumeHandlers) getNamespaceVolumeViewsHandler(ctx *gin.Context) {
+	ret, err := vh.acts.GetNamespaceVolumeViews(ctx.Request.Context(), ctx.Param("ns_id"))
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ret)
+}
+
+func (vh *volumeHandlers) reconcileBillingHandler(ctx *gin.Context) {
+	fix, err := getBoolParam(ctx.Request.URL.Query(), "fix")
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	report, err := vh.acts.ReconcileBilling(ctx.Request.Context(), fix)
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (vh *volumeHandlers) migrateTariffHandler(ctx *gin.Context) {
+	var req model.TariffMigrationRequest
+	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.BadRequest(ctx, err))
+		return
+	}
+	report, err := vh.acts.MigrateTariff(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (vh *volumeHandlers) getMeteringHandler(ctx *gin.Context) {
+	query := ctx.Request.URL.Query()
+	to, err := getTimeParam(query, "to", time.Now())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+	from, err := getTimeParam(query, "from", to.Add(-server.DefaultMeteringRange))
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+	format := query.Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailF("unknown format %q", format), ctx)
+		return
+	}
+
+	report, err := vh.acts.GetMetering(ctx.Request.Context(), from, to)
+	if err != nil {
+		ctx.AbortWithStatusJSON(vh.tv.HandleError(err))
+		return
+	}
+
+	if format == "csv" {
+		ctx.Header("Content-Disposition", fmt.Sprintf("attachment; filename=metering_%s_%s.csv", from.Format("20060102T150405"), to.Format("20060102T150405")))
+		ctx.Header("Content-Type", "text/csv")
+		ctx.Status(http.StatusOK)
+		if err := report.WriteCSV(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+		return
+	}
+
+	ctx.JSON(http.StatusOK, report)
+}
+
+func (vh *volumeHandlers) tariffCacheStatsHandler(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, vh.acts.TariffCacheStats(ctx.Request.Context()))
+}
+
+func (vh *volumeHandlers) invalidateTariffCacheHandler(ctx *gin.Context) {
+	query := ctx.Request.URL.Query()
+	ctx.JSON(http.StatusOK, vh.acts.InvalidateTariffCache(ctx.Request.Context(), query["tariff_id"], query["ns_id"]))
+}
+
 func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	handlers := &volumeHandlers{tv: r.tv, acts: acts}
 
@@ -238,6 +399,31 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//     $ref: '#/responses/error'
 	group.POST("", middleware.WriteAccess, handlers.createVolumeHandler)
 
+	// swagger:operation POST /namespaces/{ns_id}/volumes/quote Volumes QuoteVolume
+	//
+	// Get capacity, storage, price and headroom of volume creation or resize without performing it.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/NamespaceID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      $ref: '#/definitions/VolumeQuoteRequest'
+	// responses:
+	//   '200':
+	//     description: volume quote
+	//     schema:
+	//       $ref: '#/definitions/VolumeQuote'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.POST("/quote", middleware.ReadAccess, handlers.quoteVolumeHandler)
+
 	// swagger:operation GET /namespaces/{ns_id}/volumes/{label} Volumes GetVolume
 	//
 	// Get volume.
@@ -247,6 +433,7 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//  - $ref: '#/parameters/UserIDHeader'
 	//  - $ref: '#/parameters/UserRoleHeader'
 	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
 	//  - $ref: '#/parameters/NamespaceID'
 	//  - name: label
 	//    in: path
@@ -256,7 +443,7 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//   '200':
 	//     description: volume response
 	//     schema:
-	//       $ref: '#/definitions/Volume'
+	//       $ref: '#/definitions/VolumeResponse'
 	//   default:
 	//     $ref: '#/responses/error'
 	group.GET("/:label", middleware.ReadAccess, handlers.getVolumeHandler)
@@ -270,14 +457,23 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//  - $ref: '#/parameters/UserIDHeader'
 	//  - $ref: '#/parameters/UserRoleHeader'
 	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
 	//  - $ref: '#/parameters/NamespaceID'
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
 	// responses:
 	//   '200':
 	//     description: volumes response
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
 	//     schema:
-	//       type: array
-	//       items:
-	//         $ref: '#/definitions/Volume'
+	//       $ref: '#/definitions/VolumesResponse'
 	//   default:
 	//     $ref: '#/responses/error'
 	group.GET("", middleware.ReadAccess, handlers.getNamespaceVolumesHandler)
@@ -291,13 +487,22 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//  - $ref: '#/parameters/UserIDHeader'
 	//  - $ref: '#/parameters/UserRoleHeader'
 	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
 	// responses:
 	//   '200':
 	//     description: volumes response
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
 	//     schema:
-	//       type: array
-	//       items:
-	//         $ref: '#/definitions/Volume'
+	//       $ref: '#/definitions/VolumesResponse'
 	//   default:
 	//     $ref: '#/responses/error'
 	r.engine.GET("/volumes", middleware.ReadAccess, handlers.getUserVolumesHandler)
@@ -311,16 +516,35 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//  - $ref: '#/parameters/UserIDHeader'
 	//  - $ref: '#/parameters/UserRoleHeader'
 	//  - $ref: '#/parameters/SubstitutedUserID'
-	//  - $ref: '#/parameters/Filters'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - name: filter
+	//    in: query
+	//    type: string
+	//    description: >
+	//      comma-separated filters: "deleted", "not_deleted" (default) flags, equality conditions
+	//      "owner:", "namespace:", "storage:", "tariff:", "cluster:", "label_prefix:" and range conditions
+	//      on "capacity", "create_time", "delete_time" with one of =, >, >=, <, <= (e.g. "capacity>=10Gi")
+	//  - name: sort
+	//    in: query
+	//    type: string
+	//    description: >
+	//      comma-separated sort fields, "-" prefix for descending order: create_time, delete_time, capacity,
+	//      label, storage, namespace, owner, tariff, cluster
 	//  - $ref: '#/parameters/PageNum'
 	//  - $ref: '#/parameters/PerPageLimit'
+	//  - $ref: '#/parameters/PageCursor'
 	// responses:
 	//   '200':
 	//     description: volumes response
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
 	//     schema:
-	//       type: array
-	//       items:
-	//         $ref: '#/definitions/Volume'
+	//       $ref: '#/definitions/VolumesResponse'
 	//   default:
 	//     $ref: '#/responses/error'
 	r.engine.GET("/admin/volumes", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.getAllVolumesHandler)
@@ -405,6 +629,42 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//     $ref: '#/responses/error'
 	group.PUT("/:label", middleware.WriteAccess, handlers.resizeVolumeHandler)
 
+	// swagger:operation PATCH /namespaces/{ns_id}/volumes/{label} Volumes PatchVolume
+	//
+	// Change volume with JSON merge patch (RFC 7386).
+	// Label, tariff, capacity (admins only), access mode and metadata can be patched, all changes are applied atomically.
+	// Label change is rejected with 400 if kube backend of volume cluster can not rename volumes (kubernetes backend),
+	// access mode change is rejected with 400 if it can not change access mode of existing volume (kubernetes backend).
+	// Error details name the backend.
+	//
+	// ---
+	// consumes:
+	//  - application/merge-patch+json
+	//  - application/json
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - $ref: '#/parameters/NamespaceID'
+	//  - name: label
+	//    in: path
+	//    type: string
+	//    required: true
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      $ref: '#/definitions/VolumePatch'
+	// responses:
+	//   '200':
+	//     description: patched volume
+	//     schema:
+	//       $ref: '#/definitions/VolumeResponse'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.PATCH("/:label", middleware.WriteAccess, handlers.patchVolumeHandler)
+
 	// swagger:operation PUT /admin/namespaces/{ns_id}/volumes/{label} Volumes AdminResizeVolume
 	//
 	// Resize volume (admins only).
@@ -431,6 +691,50 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//     $ref: '#/responses/error'
 	adminGroup.PUT("/:label", handlers.adminResizeVolumeHandler)
 
+	// swagger:operation GET /admin/namespaces/{ns_id}/volumes/{label} Volumes GetVolumeViews
+	//
+	// Get volume as stored in database and as reported by kube backend (admins only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - $ref: '#/parameters/NamespaceID'
+	//  - name: label
+	//    in: path
+	//    type: string
+	//    required: true
+	// responses:
+	//   '200':
+	//     description: volume views
+	//     schema:
+	//       $ref: '#/definitions/VolumeViews'
+	//   default:
+	//     $ref: '#/responses/error'
+	adminGroup.GET("/:label", handlers.getVolumeViewsHandler)
+
+	// swagger:operation GET /admin/namespaces/{ns_id}/volumes Volumes GetNamespaceVolumeViews
+	//
+	// Get namespace volumes as stored in database and as reported by kube backend (admins only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - $ref: '#/parameters/SubstitutedUserID'
+	//  - $ref: '#/parameters/ResponseUnits'
+	//  - $ref: '#/parameters/NamespaceID'
+	// responses:
+	//   '200':
+	//     description: namespace volume views
+	//     schema:
+	//       $ref: '#/definitions/NamespaceVolumeViews'
+	//   default:
+	//     $ref: '#/responses/error'
+	adminGroup.GET("", handlers.getNamespaceVolumeViewsHandler)
+
 	// swagger:operation POST /import/volumes Volumes ImportVolumes
 	//
 	// Import volumes.
@@ -452,4 +756,142 @@ func (r *Router) SetupVolumeHandlers(acts server.VolumeActions) {
 	//   default:
 	//     $ref: '#/responses/error'
 	r.engine.POST("/import/volumes", handlers.importVolumesHandler)
+
+	// swagger:operation POST /reconcile/billing Volumes ReconcileBilling
+	//
+	// Compare billing subscriptions with live tariffed volumes (admin only).
+	// If fix is set, orphaned subscriptions are removed, missing ones are created
+	// and mismatched ones are resubscribed to volume tariff.
+	// Subscriptions of volumes resized by admin are kept.
+	// Billing API has no endpoint listing subscriptions, so only fake billing supports reconciliation,
+	// 501 is returned otherwise.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: fix
+	//    in: query
+	//    type: boolean
+	//    required: false
+	// responses:
+	//   '200':
+	//     description: billing reconciliation report
+	//     schema:
+	//       $ref: '#/definitions/BillingReconciliationReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.POST("/reconcile/billing", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.reconcileBillingHandler)
+
+	// swagger:operation POST /migrate/tariffs Volumes MigrateTariff
+	//
+	// Move all volumes from one tariff to another (admin only).
+	// Volumes are migrated in batches, ones which would be down resized are skipped.
+	// Billing subscription and kube capacity are updated for each volume.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      $ref: '#/definitions/TariffMigrationRequest'
+	// responses:
+	//   '200':
+	//     description: per-volume migration results
+	//     schema:
+	//       $ref: '#/definitions/TariffMigrationReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.POST("/migrate/tariffs", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.migrateTariffHandler)
+
+	// swagger:operation GET /admin/cache/tariffs Volumes TariffCacheStats
+	//
+	// Get billing tariffs cache statistics (admin only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	// responses:
+	//   '200':
+	//     description: tariff cache statistics
+	//     schema:
+	//       $ref: '#/definitions/TariffCacheStats'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.GET("/admin/cache/tariffs", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.tariffCacheStatsHandler)
+
+	// swagger:operation DELETE /admin/cache/tariffs Volumes InvalidateTariffCache
+	//
+	// Invalidate cached billing tariffs (admin only).
+	// If no ids provided, whole cache is purged.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: tariff_id
+	//    in: query
+	//    type: array
+	//    items:
+	//      type: string
+	//    collectionFormat: multi
+	//    required: false
+	//  - name: ns_id
+	//    in: query
+	//    type: array
+	//    items:
+	//      type: string
+	//    collectionFormat: multi
+	//    required: false
+	// responses:
+	//   '200':
+	//     description: tariff cache statistics after invalidation
+	//     schema:
+	//       $ref: '#/definitions/TariffCacheStats'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.DELETE("/admin/cache/tariffs", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.invalidateTariffCacheHandler)
+
+	// swagger:operation GET /admin/metering Volumes GetMetering
+	//
+	// Get GiB-hours allocated by volumes in time range (admin only).
+	// Allocation is split by volume, tariff, storage and namespace.
+	// Free volumes have zero tariff id, volumes created or resized by admin have empty one.
+	//
+	// ---
+	// produces:
+	//  - application/json
+	//  - text/csv
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: from
+	//    in: query
+	//    type: string
+	//    format: date-time
+	//    description: defaults to 30 days before range end
+	//    required: false
+	//  - name: to
+	//    in: query
+	//    type: string
+	//    format: date-time
+	//    description: defaults to current time
+	//    required: false
+	//  - name: format
+	//    in: query
+	//    type: string
+	//    enum: [json, csv]
+	//    required: false
+	// responses:
+	//   '200':
+	//     description: metering report
+	//     schema:
+	//       $ref: '#/definitions/MeteringReport'
+	//   default:
+	//     $ref: '#/responses/error'
+	r.engine.GET("/admin/metering", httputil.RequireAdminRole(errors.ErrAdminRequired), handlers.getMeteringHandler)
 }
@@ -0,0 +1,179 @@
+package router
+
+import (
+	"net/http"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/server"
+	"github.com/containerum/cherry/adaptors/gonic"
+	"github.com/containerum/utils/httputil"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+type webhookHandlers struct {
+	tv   *TranslateValidate
+	acts server.WebhookActions
+}
+
+func (wh *webhookHandlers) createWebhookHandler(ctx *gin.Context) {
+	var req model.WebhookSubscriptionRequest
+	if err := ctx.ShouldBindWith(&req, binding.JSON); err != nil {
+		ctx.AbortWithStatusJSON(wh.tv.BadRequest(ctx, err))
+		return
+	}
+
+	ret, err := wh.acts.CreateWebhook(ctx.Request.Context(), req)
+	if err != nil {
+		ctx.AbortWithStatusJSON(wh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, ret)
+}
+
+func (wh *webhookHandlers) getWebhooksHandler(ctx *gin.Context) {
+	ret, err := wh.acts.GetWebhooks(ctx.Request.Context())
+	if err != nil {
+		ctx.AbortWithStatusJSON(wh.tv.HandleError(err))
+		return
+	}
+
+	ctx.JSON(http.StatusOK, ret)
+}
+
+func (wh *webhookHandlers) deleteWebhookHandler(ctx *gin.Context) {
+	if err := wh.acts.DeleteWebhook(ctx.Request.Context(), ctx.Param("id")); err != nil {
+		ctx.AbortWithStatusJSON(wh.tv.HandleError(err))
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
+
+func (wh *webhookHandlers) getWebhookDeliveriesHandler(ctx *gin.Context) {
+	page, err := getPageRequest(ctx.Request.URL.Query())
+	if err != nil {
+		gonic.Gonic(errors.ErrRequestValidationFailed().AddDetailsErr(err), ctx)
+		return
+	}
+
+	status := model.WebhookDeliveryStatus(ctx.Query("status"))
+	ret, pagination, err := wh.acts.GetWebhookDeliveries(ctx.Request.Context(), ctx.Param("id"), status, page)
+	if err != nil {
+		ctx.AbortWithStatusJSON(wh.tv.HandleError(err))
+		return
+	}
+
+	setPaginationHeaders(ctx, pagination)
+	ctx.JSON(http.StatusOK, ret)
+}
+
+func (r *Router) SetupWebhookHandlers(acts server.WebhookActions) {
+	handlers := &webhookHandlers{tv: r.tv, acts: acts}
+
+	group := r.engine.Group("/admin/webhooks", httputil.RequireAdminRole(errors.ErrAdminRequired))
+
+	// swagger:operation POST /admin/webhooks Webhooks CreateWebhook
+	//
+	// Subscribe endpoint to events (admin only).
+	// Events: volume.created, volume.resized, volume.deleted, volume.restored, storage.near_full.
+	// Deliveries are posted as WebhookPayload and signed with HMAC-SHA256 of "<X-Webhook-Timestamp>.<body>"
+	// keyed with subscription secret, signature is passed in X-Webhook-Signature as "sha256=<hex>".
+	// Failed deliveries are retried with exponential backoff.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: body
+	//    in: body
+	//    required: true
+	//    schema:
+	//      $ref: '#/definitions/WebhookSubscriptionRequest'
+	// responses:
+	//   '201':
+	//     description: subscription created, secret is returned only here
+	//     schema:
+	//       $ref: '#/definitions/WebhookSubscription'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.POST("", handlers.createWebhookHandler)
+
+	// swagger:operation GET /admin/webhooks Webhooks GetWebhooks
+	//
+	// Get webhook subscriptions without secrets (admin only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	// responses:
+	//   '200':
+	//     description: subscriptions list
+	//     schema:
+	//       type: array
+	//       items:
+	//         $ref: '#/definitions/WebhookSubscription'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.GET("", handlers.getWebhooksHandler)
+
+	// swagger:operation DELETE /admin/webhooks/{id} Webhooks DeleteWebhook
+	//
+	// Delete webhook subscription with its delivery logs (admin only).
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: id
+	//    in: path
+	//    type: string
+	//    required: true
+	// responses:
+	//   '202':
+	//     description: subscription deleted
+	//   default:
+	//     $ref: '#/responses/error'
+	group.DELETE("/:id", handlers.deleteWebhookHandler)
+
+	// swagger:operation GET /admin/webhooks/{id}/deliveries Webhooks GetWebhookDeliveries
+	//
+	// Get delivery logs of webhook subscription, newest first (admin only).
+	// Deliveries are always paginated, cursors are not supported.
+	//
+	// ---
+	// parameters:
+	//  - $ref: '#/parameters/UserIDHeader'
+	//  - $ref: '#/parameters/UserRoleHeader'
+	//  - name: id
+	//    in: path
+	//    type: string
+	//    required: true
+	//  - name: status
+	//    in: query
+	//    type: string
+	//    enum: [pending, delivered, failed]
+	//    required: false
+	//  - $ref: '#/parameters/PageNum'
+	//  - $ref: '#/parameters/PerPageLimit'
+	// responses:
+	//   '200':
+	//     description: deliveries list
+	//     headers:
+	//       X-Total-Count:
+	//         type: integer
+	//         description: total number of items
+	//       Link:
+	//         type: string
+	//         description: links to next, previous, first and last pages (RFC 8288)
+	//     schema:
+	//       type: array
+	//       items:
+	//         $ref: '#/definitions/WebhookDelivery'
+	//   default:
+	//     $ref: '#/responses/error'
+	group.GET("/:id/deliveries", handlers.getWebhookDeliveriesHandler)
+}
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+// BillingDebugActions manages in-process fake billing
+type BillingDebugActions interface {
+	GetFakeBillingState(ctx context.Context) (model.FakeBillingState, error)
+	SetFakeBillingFailures(ctx context.Context, failures []model.BillingFailure) (model.FakeBillingState, error)
+}
+
+type fakeBilling interface {
+	FakeBillingState() model.FakeBillingState
+	SetFailures(failures []model.BillingFailure)
+}
+
+// fakeBilling returns fake billing client, possibly wrapped by cache
+func (s *Server) fakeBilling() (fakeBilling, error) {
+	billing := s.clients.Billing
+	for {
+		if fake, ok := billing.(fakeBilling); ok {
+			return fake, nil
+		}
+		wrapper, ok := billing.(interface{ Unwrap() clients.BillingClient })
+		if !ok {
+			return nil, errors.ErrResourceNotExists().AddDetailF("fake billing is not enabled")
+		}
+		billing = wrapper.Unwrap()
+	}
+}
+
+func (s *Server) GetFakeBillingState(ctx context.Context) (model.FakeBillingState, error) {
+	fake, err := s.fakeBilling()
+	if err != nil {
+		return model.FakeBillingState{}, err
+	}
+	return fake.FakeBillingState(), nil
+}
+
+func (s *Server) SetFakeBillingFailures(ctx context.Context, failures []model.BillingFailure) (model.FakeBillingState, error) {
+	s.log.WithField("failures", failures).Infof("set fake billing failures")
+
+	fake, err := s.fakeBilling()
+	if err != nil {
+		return model.FakeBillingState{}, err
+	}
+	fake.SetFailures(failures)
+	return fake.FakeBillingState(), nil
+}
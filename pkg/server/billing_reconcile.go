@@ -0,0 +1,134 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	billing "github.com/containerum/bill-external/models"
+	kubeModel "github.com/containerum/kube-client/pkg/model"
+	"github.com/sirupsen/logrus"
+)
+
+// ReconcileBilling compares volume subscriptions known to billing with live tariffed volumes.
+// If fix is true, orphaned subscriptions are removed, missing ones are created
+// and mismatched ones are resubscribed to volume tariff. Fixes are made on behalf of volume owners.
+// Volumes resized by admin have no tariff but keep subscription, such subscriptions are left as is.
+func (s *Server) ReconcileBilling(ctx context.Context, fix bool) (model.BillingReconciliationReport, error) {
+	s.log.WithField("fix", fix).Infof("reconcile billing")
+
+	ret := model.BillingReconciliationReport{
+		Fix:                         fix,
+		SubscriptionsWithoutVolumes: make([]model.BillingSubscription, 0),
+		VolumesWithoutSubscriptions: make([]model.ReconciliationVolume, 0),
+		TariffMismatches:            make([]model.TariffMismatch, 0),
+		Failed:                      make([]kubeModel.ImportResult, 0),
+	}
+
+	subscriptions, err := s.clients.Billing.ListSubscriptions(ctx, billing.Volume)
+	if err != nil {
+		return ret, err
+	}
+
+	vols, err := s.db.AllVolumes(ctx, StandardVolumeFilter)
+	if err != nil {
+		return ret, err
+	}
+
+	volumes := make(map[string]model.Volume, len(vols))
+	untariffed := make(map[string]bool)
+	for _, vol := range vols {
+		if vol.TariffID == nil {
+			untariffed[vol.ID] = true
+		}
+		// free volumes and volumes resized by admin are not billed
+		if vol.TariffID == nil || *vol.TariffID == ZeroUUID {
+			continue
+		}
+		volumes[vol.ID] = vol
+	}
+
+	subscribed := make(map[string]bool, len(subscriptions))
+	for _, sub := range subscriptions {
+		subscribed[sub.ResourceID] = true
+
+		vol, exists := volumes[sub.ResourceID]
+		switch {
+		case untariffed[sub.ResourceID]:
+			// pass
+		case !exists:
+			ret.SubscriptionsWithoutVolumes = append(ret.SubscriptionsWithoutVolumes, sub)
+		case *vol.TariffID != sub.TariffID:
+			ret.TariffMismatches = append(ret.TariffMismatches, model.TariffMismatch{
+				ReconciliationVolume: reconciliationVolume(vol),
+				SubscriptionTariffID: sub.TariffID,
+			})
+		}
+	}
+
+	for _, vol := range vols {
+		if _, tariffed := volumes[vol.ID]; tariffed && !subscribed[vol.ID] {
+			ret.VolumesWithoutSubscriptions = append(ret.VolumesWithoutSubscriptions, reconciliationVolume(vol))
+		}
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"subscriptions_without_volumes": len(ret.SubscriptionsWithoutVolumes),
+		"volumes_without_subscriptions": len(ret.VolumesWithoutSubscriptions),
+		"tariff_mismatches":             len(ret.TariffMismatches),
+	}).Infof("billing reconciled")
+
+	if !fix {
+		return ret, nil
+	}
+
+	failed := func(name, nsID string, err error) {
+		s.log.WithError(err).WithField("resource_id", name).Warnf("billing reconciliation fix failed")
+		ret.Failed = append(ret.Failed, kubeModel.ImportResult{Name: name, Namespace: nsID, Message: err.Error()})
+	}
+
+	for _, sub := range ret.SubscriptionsWithoutVolumes {
+		subCtx := ctx
+		if sub.UserID != "" {
+			subCtx = OwnerContext(ctx, sub.UserID)
+		}
+		if unsubErr := s.clients.Billing.Unsubscribe(subCtx, sub.ResourceID); unsubErr != nil {
+			failed(sub.ResourceID, "", unsubErr)
+		}
+	}
+	for _, vol := range ret.VolumesWithoutSubscriptions {
+		if subErr := s.clients.Billing.Subscribe(OwnerContext(ctx, vol.OwnerUserID), subscribeRequest(vol)); subErr != nil {
+			failed(vol.ID, vol.NamespaceID, subErr)
+		}
+	}
+	for _, mismatch := range ret.TariffMismatches {
+		ownerCtx := OwnerContext(ctx, mismatch.OwnerUserID)
+		if unsubErr := s.clients.Billing.Unsubscribe(ownerCtx, mismatch.ID); unsubErr != nil {
+			failed(mismatch.ID, mismatch.NamespaceID, unsubErr)
+			continue
+		}
+		if subErr := s.clients.Billing.Subscribe(ownerCtx, subscribeRequest(mismatch.ReconciliationVolume)); subErr != nil {
+			failed(mismatch.ID, mismatch.NamespaceID, subErr)
+		}
+	}
+
+	return ret, nil
+}
+
+func reconciliationVolume(vol model.Volume) model.ReconciliationVolume {
+	return model.ReconciliationVolume{
+		ID:          vol.ID,
+		NamespaceID: vol.NamespaceID,
+		Label:       vol.Label,
+		TariffID:    *vol.TariffID,
+		OwnerUserID: vol.OwnerUserID,
+	}
+}
+
+func subscribeRequest(vol model.ReconciliationVolume) billing.SubscribeTariffRequest {
+	return billing.SubscribeTariffRequest{
+		TariffID:      vol.TariffID,
+		ResourceType:  billing.Volume,
+		ResourceLabel: vol.Label,
+		ResourceID:    vol.ID,
+	}
+}
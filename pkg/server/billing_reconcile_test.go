@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	billing "github.com/containerum/bill-external/models"
+	"github.com/containerum/utils/httputil"
+)
+
+// recordingBilling records users on whose behalf subscriptions were changed
+type recordingBilling struct {
+	clients.BillingClient
+
+	subscriptions []model.BillingSubscription
+	subscribedBy  map[string]string
+	unsubscribed  map[string]string
+	tariffChanges map[string]string
+}
+
+func newRecordingBilling(subscriptions ...model.BillingSubscription) *recordingBilling {
+	return &recordingBilling{
+		subscriptions: subscriptions,
+		subscribedBy:  make(map[string]string),
+		unsubscribed:  make(map[string]string),
+		tariffChanges: make(map[string]string),
+	}
+}
+
+func (b *recordingBilling) ListSubscriptions(ctx context.Context, resourceType billing.ResourceType) ([]model.BillingSubscription, error) {
+	return b.subscriptions, nil
+}
+
+func (b *recordingBilling) Subscribe(ctx context.Context, req billing.SubscribeTariffRequest) error {
+	b.subscribedBy[req.ResourceID] = httputil.RequestHeaders(ctx).Get(httputil.UserIDXHeader)
+	return nil
+}
+
+func (b *recordingBilling) ChangeTariff(ctx context.Context, resourceID, tariffID string) error {
+	b.tariffChanges[resourceID] = httputil.RequestHeaders(ctx).Get(httputil.UserIDXHeader) + ":" + tariffID
+	return nil
+}
+
+func (b *recordingBilling) Unsubscribe(ctx context.Context, resourceID string) error {
+	b.unsubscribed[resourceID] = httputil.RequestHeaders(ctx).Get(httputil.UserIDXHeader)
+	return nil
+}
+
+func TestReconcileBillingFixesOnBehalfOfOwners(t *testing.T) {
+	tariff, otherTariff := "tariff", "other-tariff"
+	db := newMemoryDB()
+	db.volumes = []model.Volume{
+		{Resource: model.Resource{ID: "missing", OwnerUserID: "alice", TariffID: &tariff}},
+		{Resource: model.Resource{ID: "mismatch", OwnerUserID: "bob", TariffID: &otherTariff}},
+		{Resource: model.Resource{ID: "resized", OwnerUserID: "carol"}},
+	}
+	bill := newRecordingBilling(
+		model.BillingSubscription{ResourceID: "mismatch", TariffID: tariff},
+		model.BillingSubscription{ResourceID: "resized", TariffID: tariff},
+	)
+	s := NewServer(db, &Clients{Billing: bill})
+
+	if _, err := s.ReconcileBilling(SystemContext(context.Background()), true); err != nil {
+		t.Fatal(err)
+	}
+	if user := bill.subscribedBy["missing"]; user != "alice" {
+		t.Errorf("expected missing subscription created for owner, got %q", user)
+	}
+	if user := bill.subscribedBy["mismatch"]; user != "bob" {
+		t.Errorf("expected mismatched subscription recreated for owner, got %q", user)
+	}
+	if user := bill.unsubscribed["mismatch"]; user != "bob" {
+		t.Errorf("expected mismatched subscription removed for owner, got %q", user)
+	}
+	if _, ok := bill.unsubscribed["resized"]; ok {
+		t.Errorf("expected subscription of volume resized by admin to be kept")
+	}
+}
@@ -0,0 +1,218 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/alerts"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/sirupsen/logrus"
+)
+
+// Sources of capacity threshold state, alerts and webhooks are configured with own thresholds
+const (
+	alertsThresholdSource   = "alerts"
+	webhooksThresholdSource = "webhooks"
+)
+
+// capacityThresholds are sorted thresholds in percents.
+// Event is emitted once when usage crosses threshold and again only after usage fell below it,
+// highest reached threshold of every storage and namespace is kept in database.
+type capacityThresholds []float64
+
+func newCapacityThresholds(thresholds []float64) capacityThresholds {
+	sorted := append(capacityThresholds(nil), thresholds...)
+	sort.Float64s(sorted)
+	return sorted
+}
+
+// reached returns highest threshold reached by usage, zero if none
+func (t capacityThresholds) reached(usage float64) float64 {
+	var threshold float64
+	for _, th := range t {
+		if usage >= th {
+			threshold = th
+		}
+	}
+	return threshold
+}
+
+type capacityAlerts struct {
+	notifier   alerts.Notifier
+	thresholds capacityThresholds
+}
+
+// WithCapacityAlerts enables alerts when storage fill level or namespace quota usage reaches one of thresholds (in percents).
+// Namespace quota is a volume size provided by namespace tariff, only free volumes are counted against it.
+func WithCapacityAlerts(notifier alerts.Notifier, thresholds ...float64) Option {
+	return func(s *Server) {
+		if notifier == nil || len(thresholds) == 0 {
+			return
+		}
+		s.capacityAlerts = &capacityAlerts{
+			notifier:   notifier,
+			thresholds: newCapacityThresholds(thresholds),
+		}
+	}
+}
+
+// storageNearFullEnabled reports if storage.near_full webhook event is emitted
+func (s *Server) storageNearFullEnabled() bool {
+	return s.webhookSender != nil && len(s.storageNearFullThresholds) > 0
+}
+
+// raiseCapacityThreshold stores threshold reached by usage. Alert is returned only if threshold is higher than previously reached one.
+func (s *Server) raiseCapacityThreshold(ctx context.Context, source string, thresholds capacityThresholds,
+	kind model.CapacityAlertKind, name string, used, limit model.Quantity) (model.CapacityAlert, bool) {
+	if limit <= 0 {
+		return model.CapacityAlert{}, false
+	}
+	usage := float64(used) / float64(limit) * 100
+
+	state := model.CapacityThreshold{
+		Source:    source,
+		Kind:      kind,
+		Name:      name,
+		Threshold: thresholds.reached(usage),
+	}
+	raised, err := s.db.RaiseCapacityThreshold(ctx, &state)
+	if err != nil {
+		s.log.WithError(err).WithFields(logrus.Fields{
+			"source": source,
+			"kind":   kind,
+			"name":   name,
+		}).Warnf("unable to store capacity threshold")
+		return model.CapacityAlert{}, false
+	}
+	if !raised {
+		return model.CapacityAlert{}, false
+	}
+
+	return model.CapacityAlert{
+		Kind:      kind,
+		Name:      name,
+		Threshold: state.Threshold,
+		Usage:     usage,
+		Used:      used,
+		Limit:     limit,
+		Time:      time.Now().UTC(),
+	}, true
+}
+
+func (s *Server) notifyCapacity(ctx context.Context, kind model.CapacityAlertKind, name string, used, limit model.Quantity) {
+	if s.capacityAlerts != nil {
+		if alert, ok := s.raiseCapacityThreshold(ctx, alertsThresholdSource, s.capacityAlerts.thresholds, kind, name, used, limit); ok {
+			if err := s.capacityAlerts.notifier.Notify(ctx, alert); err != nil {
+				s.log.WithError(err).WithFields(logrus.Fields{
+					"kind": kind,
+					"name": name,
+				}).Warnf("capacity alert notification failed")
+			}
+		}
+	}
+
+	if kind == model.StorageCapacityAlert && s.storageNearFullEnabled() {
+		if alert, ok := s.raiseCapacityThreshold(ctx, webhooksThresholdSource, s.storageNearFullThresholds, kind, name, used, limit); ok {
+			s.emitWebhookEvent(ctx, model.WebhookPayload{
+				Event: model.StorageNearFullEvent,
+				Alert: &alert,
+			})
+		}
+	}
+}
+
+func (s *Server) checkNamespaceCapacity(ctx context.Context, usage model.NamespaceStorageUsage) error {
+	tariff, err := s.clients.Billing.GetTariffForNamespace(ctx, usage.NamespaceID)
+	if err != nil {
+		return err
+	}
+	s.notifyCapacity(ctx, model.NamespaceCapacityAlert, usage.NamespaceID, usage.Capacity, model.GiBytes(tariff.VolumeSize))
+	return nil
+}
+
+// capacityChanged evaluates thresholds of storages and namespaces of changed volumes in background,
+// so namespace tariff requests to billing do not delay response. Checks are made on behalf of system user.
+// Namespaces are evaluated only for alerts. Changes are already committed at this point, so errors are only logged.
+func (s *Server) capacityChanged(ctx context.Context, volumes ...model.Volume) {
+	if s.capacityAlerts == nil && !s.storageNearFullEnabled() {
+		return
+	}
+
+	// request context is cancelled after response
+	checkCtx := SystemContext(detachedContext(ctx))
+	s.background.Add(1)
+	go func() {
+		defer s.background.Done()
+		s.checkVolumesCapacity(checkCtx, volumes)
+	}()
+}
+
+func (s *Server) checkVolumesCapacity(ctx context.Context, volumes []model.Volume) {
+	storageNames := make(map[string]bool)
+	nsIDs := make(map[string]bool)
+	for _, v := range volumes {
+		storageNames[v.StorageName] = true
+		nsIDs[v.NamespaceID] = true
+	}
+
+	for name := range storageNames {
+		storage, err := s.db.StorageByName(ctx, name)
+		if err != nil {
+			s.log.WithError(err).WithField("name", name).Warnf("storage capacity check failed")
+			continue
+		}
+		s.notifyCapacity(ctx, model.StorageCapacityAlert, storage.Name, storage.Used, storage.Size)
+	}
+
+	if s.capacityAlerts == nil {
+		return
+	}
+	for nsID := range nsIDs {
+		usage, err := s.db.NamespacesQuotaUsage(ctx, nsID)
+		if err != nil {
+			s.log.WithError(err).WithField("ns_id", nsID).Warnf("namespace capacity check failed")
+			continue
+		}
+		if len(usage) == 0 {
+			usage = append(usage, model.NamespaceStorageUsage{NamespaceID: nsID})
+		}
+		if err := s.checkNamespaceCapacity(ctx, usage[0]); err != nil {
+			s.log.WithError(err).WithField("ns_id", nsID).Warnf("namespace capacity check failed")
+		}
+	}
+}
+
+// CheckCapacityThresholds evaluates thresholds of all storages and namespaces
+func (s *Server) CheckCapacityThresholds(ctx context.Context) error {
+	if s.capacityAlerts == nil && !s.storageNearFullEnabled() {
+		return nil
+	}
+	s.log.Debugf("check capacity thresholds")
+
+	storages, err := s.db.AllStorages(ctx)
+	if err != nil {
+		return err
+	}
+	for _, storage := range storages {
+		if storage.Deleted {
+			continue
+		}
+		s.notifyCapacity(ctx, model.StorageCapacityAlert, storage.Name, storage.Used, storage.Size)
+	}
+
+	if s.capacityAlerts == nil {
+		return nil
+	}
+	usage, err := s.db.NamespacesQuotaUsage(ctx)
+	if err != nil {
+		return err
+	}
+	for _, nsUsage := range usage {
+		if err := s.checkNamespaceCapacity(ctx, nsUsage); err != nil {
+			s.log.WithError(err).WithField("ns_id", nsUsage.NamespaceID).Warnf("namespace capacity check failed")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/alerts"
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/webhooks"
+	billing "github.com/containerum/bill-external/models"
+	"github.com/containerum/utils/httputil"
+)
+
+func TestCapacityAlerts(t *testing.T) {
+	notifier := alerts.NewMemoryNotifier()
+	s := NewServer(newMemoryDB(), &Clients{}, WithCapacityAlerts(notifier, 95, 80))
+	ctx := context.Background()
+
+	steps := []struct {
+		used      model.Quantity
+		threshold float64 // zero if no alert expected
+	}{
+		{used: 50 * model.GiB},
+		{used: 85 * model.GiB, threshold: 80},
+		{used: 90 * model.GiB},
+		{used: 99 * model.GiB, threshold: 95},
+		{used: 97 * model.GiB},
+		{used: 10 * model.GiB},
+		{used: 96 * model.GiB, threshold: 95},
+	}
+
+	expected := 0
+	for i, step := range steps {
+		s.notifyCapacity(ctx, model.StorageCapacityAlert, "storage", step.used, 100*model.GiB)
+		if step.threshold != 0 {
+			expected++
+		}
+		received := notifier.Alerts()
+		if len(received) != expected {
+			t.Fatalf("step %d: expected %d alerts, got %d", i, expected, len(received))
+		}
+		if step.threshold != 0 && received[expected-1].Threshold != step.threshold {
+			t.Errorf("step %d: expected threshold %v, got %v", i, step.threshold, received[expected-1].Threshold)
+		}
+	}
+
+	s.notifyCapacity(ctx, model.NamespaceCapacityAlert, "ns", 90*model.GiB, 0)
+	if len(notifier.Alerts()) != expected {
+		t.Error("alert emitted for namespace without quota")
+	}
+}
+
+func TestStorageNearFullWebhook(t *testing.T) {
+	db := newMemoryDB()
+	db.webhooks = []model.WebhookSubscription{{ID: "sub", Events: []model.WebhookEvent{model.StorageNearFullEvent}}}
+	// replicas share threshold state, capacity alerts are not configured
+	replicas := []*Server{
+		NewServer(db, &Clients{}, WithWebhooks(webhooks.NewHTTPSender(time.Second), 90)),
+		NewServer(db, &Clients{}, WithWebhooks(webhooks.NewHTTPSender(time.Second), 90)),
+	}
+	ctx := context.Background()
+
+	steps := []struct {
+		replica int
+		used    model.Quantity
+		event   bool
+	}{
+		{replica: 0, used: 85 * model.GiB},
+		{replica: 0, used: 95 * model.GiB, event: true},
+		{replica: 1, used: 96 * model.GiB},
+		{replica: 1, used: 50 * model.GiB},
+		{replica: 0, used: 92 * model.GiB, event: true},
+	}
+
+	expected := 0
+	for i, step := range steps {
+		replicas[step.replica].notifyCapacity(ctx, model.StorageCapacityAlert, "storage", step.used, 100*model.GiB)
+		if step.event {
+			expected++
+		}
+		if len(db.deliveries) != expected {
+			t.Fatalf("step %d: expected %d deliveries, got %d", i, expected, len(db.deliveries))
+		}
+	}
+	if alert := db.deliveries[0].Payload.Alert; alert == nil || alert.Threshold != 90 || alert.Name != "storage" {
+		t.Errorf("unexpected alert %+v", alert)
+	}
+}
+
+// blockingBilling answers namespace tariff requests after release
+type blockingBilling struct {
+	clients.BillingClient
+
+	release chan struct{}
+	userIDs []string
+}
+
+func (b *blockingBilling) GetTariffForNamespace(ctx context.Context, nsID string) (billing.NamespaceTariff, error) {
+	<-b.release
+	b.userIDs = append(b.userIDs, httputil.MustGetUserID(ctx))
+	return billing.NamespaceTariff{VolumeSize: 10}, nil
+}
+
+func TestCapacityChangedDoesNotWaitForBilling(t *testing.T) {
+	bill := &blockingBilling{release: make(chan struct{})}
+	db := newMemoryDB(model.Storage{Name: "storage", Size: 100 * model.GiB, Used: 10 * model.GiB})
+	s := NewServer(db, &Clients{Billing: bill}, WithCapacityAlerts(alerts.NewMemoryNotifier(), 80))
+
+	ctx, cancel := context.WithCancel(OwnerContext(context.Background(), "alice"))
+	done := make(chan struct{})
+	go func() {
+		s.capacityChanged(ctx, model.Volume{NamespaceID: "ns", StorageName: "storage"})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("capacity check blocks request until billing responds")
+	}
+
+	// request is finished, check continues
+	cancel()
+	close(bill.release)
+	s.Wait()
+	if len(bill.userIDs) != 1 || bill.userIDs[0] != ZeroUUID {
+		t.Errorf("expected one namespace tariff request made by system user, got %v", bill.userIDs)
+	}
+}
@@ -0,0 +1,155 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+// volumeCluster returns cluster volume placed in.
+// Volumes created before clusters were introduced belong to cluster owning their storage.
+func (s *Server) volumeCluster(vol model.Volume) (clients.KubeCluster, error) {
+	if vol.Cluster == "" {
+		return s.clients.Kube.StorageCluster(vol.StorageName), nil
+	}
+	cluster, ok := s.clients.Kube.Cluster(vol.Cluster)
+	if !ok {
+		return cluster, errors.ErrInternal().AddDetailF("cluster %s of volume %s is not configured", vol.Cluster, vol.Label)
+	}
+	return cluster, nil
+}
+
+// checkKubeCapacity checks kube backend of volume cluster is able to provision volume capacity
+func (s *Server) checkKubeCapacity(vol model.Volume) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	if validator, ok := cluster.Client.(clients.KubeCapacityValidator); ok {
+		return validator.ValidateCapacity(vol.Capacity)
+	}
+	return nil
+}
+
+func (s *Server) kubeCreateVolume(ctx context.Context, vol model.Volume) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	kubeVol := vol.ToKubeVolume()
+	return cluster.Client.CreateVolume(ctx, vol.NamespaceID, &kubeVol)
+}
+
+func (s *Server) kubeUpdateVolume(ctx context.Context, vol model.Volume) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	kubeVol := vol.ToKubeVolume()
+	return cluster.Client.UpdateVolume(ctx, vol.NamespaceID, &kubeVol)
+}
+
+func (s *Server) kubeDeleteVolume(ctx context.Context, vol model.Volume) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	return cluster.Client.DeleteVolume(ctx, vol.NamespaceID, vol.Label)
+}
+
+// kubeSetVolumeSuspended detaches suspended volume from workloads or attaches resumed volume back
+func (s *Server) kubeSetVolumeSuspended(ctx context.Context, vol model.Volume, suspended bool) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	suspender, ok := cluster.Client.(clients.KubeVolumeSuspender)
+	if !ok {
+		return kubeSuspendNotSupported(cluster)
+	}
+	if suspended {
+		return suspender.SuspendVolume(ctx, vol.NamespaceID, vol.Label)
+	}
+	return suspender.ResumeVolume(ctx, vol.NamespaceID, vol.Label)
+}
+
+// checkKubeSuspendable checks kube backends of all volumes are able to suspend them
+func (s *Server) checkKubeSuspendable(vols []model.Volume) error {
+	for _, vol := range vols {
+		cluster, err := s.volumeCluster(vol)
+		if err != nil {
+			return err
+		}
+		if _, ok := cluster.Client.(clients.KubeVolumeSuspender); !ok {
+			return kubeSuspendNotSupported(cluster)
+		}
+	}
+	return nil
+}
+
+func kubeSuspendNotSupported(cluster clients.KubeCluster) error {
+	return errors.ErrNotSupported().AddDetailF("%s backend of cluster %s can not suspend volumes", cluster.Backend, cluster.Name)
+}
+
+// kubeRenameVolume renames volume in kubernetes if kube backend of volume cluster supports it
+func (s *Server) kubeRenameVolume(ctx context.Context, vol model.Volume, oldLabel string) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	renamer, ok := cluster.Client.(clients.KubeVolumeRenamer)
+	if !ok {
+		return kubeRenameNotSupported(cluster)
+	}
+	return renamer.RenameVolume(ctx, vol.NamespaceID, oldLabel, vol.Label)
+}
+
+// checkKubePatch checks kube backend of volume cluster is able to apply label and access mode changes of patch
+func (s *Server) checkKubePatch(vol model.Volume, patch model.VolumePatch) error {
+	cluster, err := s.volumeCluster(vol)
+	if err != nil {
+		return err
+	}
+	if patch.Label != nil && *patch.Label != vol.Label {
+		if _, ok := cluster.Client.(clients.KubeVolumeRenamer); !ok {
+			return kubeRenameNotSupported(cluster)
+		}
+	}
+	if patch.AccessMode != nil && *patch.AccessMode != vol.AccessMode {
+		if fixed, ok := cluster.Client.(clients.KubeFixedAccessModeBackend); ok && fixed.FixedAccessMode() {
+			return errors.ErrRequestValidationFailed().AddDetailF("%s backend of cluster %s can not change access mode of volumes", cluster.Backend, cluster.Name)
+		}
+	}
+	return nil
+}
+
+func kubeRenameNotSupported(cluster clients.KubeCluster) error {
+	return errors.ErrRequestValidationFailed().AddDetailF("%s backend of cluster %s can not rename volumes", cluster.Backend, cluster.Name)
+}
+
+// volumeResponse converts volume to API representation
+func (s *Server) volumeResponse(ctx context.Context, vol model.Volume) model.VolumeResponse {
+	ret := model.VolumeResponse{
+		Volume:   vol.ToKube(),
+		Capacity: vol.Capacity.In(ResponseUnit(ctx)),
+		Cluster:  vol.Cluster,
+		Metadata: vol.Metadata,
+	}
+	if cluster, err := s.volumeCluster(vol); err == nil {
+		ret.Cluster = cluster.Name
+	}
+	return ret
+}
+
+func (s *Server) volumesResponse(ctx context.Context, vols []model.Volume, pagination model.Pagination) model.VolumesResponse {
+	ret := model.VolumesResponse{
+		Volumes:    make([]model.VolumeResponse, len(vols)),
+		Pagination: pagination,
+	}
+	for i := range vols {
+		ret.Volumes[i] = s.volumeResponse(ctx, vols[i])
+	}
+	return ret
+}
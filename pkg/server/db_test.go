@@ -0,0 +1,125 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+// memoryDB keeps storages, volumes and webhooks in memory. Methods not needed by tests panic on embedded nil interface.
+type memoryDB struct {
+	database.DB
+
+	storages   map[string]model.Storage
+	volumes    []model.Volume
+	thresholds map[model.CapacityThreshold]float64 // by threshold key with zero threshold
+
+	webhooks   []model.WebhookSubscription
+	deliveries []model.WebhookDelivery
+}
+
+func newMemoryDB(storages ...model.Storage) *memoryDB {
+	db := &memoryDB{
+		storages:   make(map[string]model.Storage),
+		thresholds: make(map[model.CapacityThreshold]float64),
+	}
+	for _, storage := range storages {
+		db.storages[storage.Name] = storage
+	}
+	return db
+}
+
+func (db *memoryDB) Transactional(fn func(tx database.DB) error) error {
+	return fn(db)
+}
+
+func (db *memoryDB) AllStorages(ctx context.Context) ([]model.Storage, error) {
+	ret := make([]model.Storage, 0, len(db.storages))
+	for _, storage := range db.storages {
+		ret = append(ret, storage)
+	}
+	return ret, nil
+}
+
+func (db *memoryDB) StorageByName(ctx context.Context, name string) (model.Storage, error) {
+	storage, ok := db.storages[name]
+	if !ok || storage.Deleted {
+		return model.Storage{}, errors.ErrResourceNotExists().AddDetailF("storage %s not exists", name)
+	}
+	return storage, nil
+}
+
+func (db *memoryDB) CreateStorage(ctx context.Context, storage *model.Storage) error {
+	db.storages[storage.Name] = *storage
+	return nil
+}
+
+func (db *memoryDB) DeleteStorage(ctx context.Context, storage *model.Storage) error {
+	storage.Deleted = true
+	db.storages[storage.Name] = *storage
+	return nil
+}
+
+func (db *memoryDB) AllVolumes(ctx context.Context, filter database.VolumeFilter) ([]model.Volume, error) {
+	ret := make([]model.Volume, 0, len(db.volumes))
+	for _, vol := range db.volumes {
+		if filter.NotDeleted && vol.Deleted {
+			continue
+		}
+		ret = append(ret, vol)
+	}
+	return ret, nil
+}
+
+func (db *memoryDB) VolumeByLabel(ctx context.Context, nsID, label string) (model.Volume, error) {
+	for _, vol := range db.volumes {
+		if vol.NamespaceID == nsID && vol.Label == label && !vol.Deleted {
+			return vol, nil
+		}
+	}
+	return model.Volume{}, errors.ErrResourceNotExists().AddDetailF("volume %s not exists", label)
+}
+
+func (db *memoryDB) UpdateVolume(ctx context.Context, volume *model.Volume) error {
+	for i := range db.volumes {
+		if db.volumes[i].ID == volume.ID {
+			db.volumes[i] = *volume
+			return nil
+		}
+	}
+	return errors.ErrResourceNotExists().AddDetailF("volume %s not exists", volume.Label)
+}
+
+func (db *memoryDB) SetVolumeStatus(ctx context.Context, volume *model.Volume, status model.VolumeStatusUpdate) error {
+	volume.Status = status.Status
+	volume.StatusReason = status.Reason
+	volume.BoundTime = status.BoundTime
+	return db.UpdateVolume(ctx, volume)
+}
+
+func (db *memoryDB) RaiseCapacityThreshold(ctx context.Context, threshold *model.CapacityThreshold) (bool, error) {
+	key := *threshold
+	key.Threshold = 0
+	previous := db.thresholds[key]
+	if threshold.Threshold <= 0 {
+		delete(db.thresholds, key)
+		return false, nil
+	}
+	db.thresholds[key] = threshold.Threshold
+	return threshold.Threshold > previous, nil
+}
+
+func (db *memoryDB) WebhookSubscriptions(ctx context.Context) ([]model.WebhookSubscription, error) {
+	return db.webhooks, nil
+}
+
+func (db *memoryDB) CreateWebhookDeliveries(ctx context.Context, deliveries []model.WebhookDelivery) error {
+	db.deliveries = append(db.deliveries, deliveries...)
+	return nil
+}
+
+func (db *memoryDB) NamespacesQuotaUsage(ctx context.Context, nsIDs ...string) ([]model.NamespaceStorageUsage, error) {
+	return nil, nil
+}
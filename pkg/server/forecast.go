@@ -0,0 +1,169 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultForecastDays is a number of days of usage history used for forecast by default
+const DefaultForecastDays = 90
+
+const day = 24 * time.Hour
+
+// RecordUsageSnapshot saves today usage of all storages and namespace allocations on them.
+// Repeated calls during a day overwrite the snapshot.
+func (s *Server) RecordUsageSnapshot(ctx context.Context) error {
+	s.log.Infof("record usage snapshot")
+
+	date := time.Now().UTC().Truncate(day)
+
+	return s.db.Transactional(func(tx database.DB) error {
+		storages, err := tx.AllStorages(ctx)
+		if err != nil {
+			return err
+		}
+
+		var storageSnapshots []model.StorageUsageSnapshot
+		var namespaceSnapshots []model.NamespaceUsageSnapshot
+		for _, storage := range storages {
+			storageSnapshots = append(storageSnapshots, model.StorageUsageSnapshot{
+				StorageName: storage.Name,
+				Date:        date,
+				Size:        storage.Size,
+				Used:        storage.Used,
+			})
+
+			usage, err := tx.StorageUsageByNamespace(ctx, storage.Name)
+			if err != nil {
+				return err
+			}
+			for _, nsUsage := range usage {
+				namespaceSnapshots = append(namespaceSnapshots, model.NamespaceUsageSnapshot{
+					StorageName: storage.Name,
+					NamespaceID: nsUsage.NamespaceID,
+					Date:        date,
+					Volumes:     nsUsage.Volumes,
+					Capacity:    nsUsage.Capacity,
+				})
+			}
+		}
+
+		return tx.SaveUsageSnapshots(ctx, storageSnapshots, namespaceSnapshots)
+	})
+}
+
+// GetStorageForecast projects when storage becomes full using linear regression over last days of usage history
+func (s *Server) GetStorageForecast(ctx context.Context, name string, days int) (model.StorageForecast, error) {
+	s.log.WithFields(logrus.Fields{
+		"name": name,
+		"days": days,
+	}).Infof("get storage forecast")
+
+	if days <= 0 {
+		days = DefaultForecastDays
+	}
+
+	storage, err := s.db.StorageByName(ctx, name)
+	if err != nil {
+		return model.StorageForecast{}, err
+	}
+
+	since := time.Now().UTC().Truncate(day).Add(-time.Duration(days) * day)
+	history, err := s.db.StorageUsageHistory(ctx, name, since)
+	if err != nil {
+		return model.StorageForecast{}, err
+	}
+	nsHistory, err := s.db.NamespaceUsageHistory(ctx, name, since)
+	if err != nil {
+		return model.StorageForecast{}, err
+	}
+
+	ret := model.StorageForecast{
+		Name:       storage.Name,
+		Size:       storage.Size,
+		Used:       storage.Used,
+		Samples:    len(history),
+		Namespaces: make([]model.NamespaceUsageGrowth, 0),
+	}
+
+	dates := make([]float64, len(history))
+	used := make([]float64, len(history))
+	for i, snapshot := range history {
+		dates[i] = snapshot.Date.Sub(since).Hours() / 24
+		used[i] = float64(snapshot.Used)
+	}
+
+	if growth, ok := linearRegressionSlope(dates, used); ok {
+		ret.GrowthPerDay = model.Quantity(growth)
+		if growth > 0 {
+			daysToFull := float64(storage.Free()) / growth
+			fullDate := time.Now().UTC().Add(time.Duration(daysToFull * float64(day)))
+			ret.DaysToFull = &daysToFull
+			ret.FullDate = &fullDate
+		}
+	}
+
+	// namespace has no snapshot on days it had no volumes on storage
+	dateIndex := make(map[time.Time]int, len(history))
+	for i, snapshot := range history {
+		dateIndex[snapshot.Date] = i
+	}
+	nsCapacity := make(map[string][]float64)
+	for _, snapshot := range nsHistory {
+		i, ok := dateIndex[snapshot.Date]
+		if !ok {
+			continue
+		}
+		if nsCapacity[snapshot.NamespaceID] == nil {
+			nsCapacity[snapshot.NamespaceID] = make([]float64, len(history))
+		}
+		nsCapacity[snapshot.NamespaceID][i] = float64(snapshot.Capacity)
+	}
+	for nsID, capacity := range nsCapacity {
+		growth, _ := linearRegressionSlope(dates, capacity)
+		ret.Namespaces = append(ret.Namespaces, model.NamespaceUsageGrowth{
+			NamespaceID:  nsID,
+			Capacity:     model.Quantity(capacity[len(capacity)-1]),
+			GrowthPerDay: model.Quantity(growth),
+		})
+	}
+	sort.Slice(ret.Namespaces, func(i, j int) bool {
+		return ret.Namespaces[i].GrowthPerDay > ret.Namespaces[j].GrowthPerDay
+	})
+
+	ret.SetUnit(ResponseUnit(ctx))
+
+	return ret, nil
+}
+
+// linearRegressionSlope returns slope of least squares line fitted to points.
+// At least two points with different x required.
+func linearRegressionSlope(xs, ys []float64) (float64, bool) {
+	n := float64(len(xs))
+	if len(xs) < 2 {
+		return 0, false
+	}
+
+	var sumX, sumY float64
+	for i := range xs {
+		sumX += xs[i]
+		sumY += ys[i]
+	}
+	meanX, meanY := sumX/n, sumY/n
+
+	var cov, varX float64
+	for i := range xs {
+		cov += (xs[i] - meanX) * (ys[i] - meanY)
+		varX += (xs[i] - meanX) * (xs[i] - meanX)
+	}
+	if varX == 0 {
+		return 0, false
+	}
+
+	return cov / varX, true
+}
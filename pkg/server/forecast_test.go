@@ -0,0 +1,28 @@
+package server
+
+import (
+	"math"
+	"testing"
+)
+
+func TestLinearRegressionSlope(t *testing.T) {
+	tests := []struct {
+		name  string
+		xs    []float64
+		ys    []float64
+		slope float64
+		ok    bool
+	}{
+		{"growing", []float64{0, 1, 2, 3}, []float64{10, 12, 14, 16}, 2, true},
+		{"noisy", []float64{0, 1, 2, 3}, []float64{10, 13, 13, 16}, 1.8, true},
+		{"shrinking", []float64{0, 2, 4}, []float64{10, 8, 6}, -1, true},
+		{"single point", []float64{0}, []float64{10}, 0, false},
+		{"same day", []float64{1, 1}, []float64{10, 20}, 0, false},
+	}
+	for _, test := range tests {
+		slope, ok := linearRegressionSlope(test.xs, test.ys)
+		if ok != test.ok || math.Abs(slope-test.slope) > 1e-9 {
+			t.Errorf("%s: expected %v (%v), got %v (%v)", test.name, test.slope, test.ok, slope, ok)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/sirupsen/logrus"
+)
+
+// DefaultMeteringRange is used when metering range start is not provided
+const DefaultMeteringRange = 30 * 24 * time.Hour
+
+// GetMetering returns GiB-hours allocated by volumes in time range split by volume, tariff, storage and namespace
+func (s *Server) GetMetering(ctx context.Context, from, to time.Time) (model.MeteringReport, error) {
+	s.log.WithFields(logrus.Fields{
+		"from": from,
+		"to":   to,
+	}).Infof("get metering")
+
+	if !from.Before(to) {
+		return model.MeteringReport{}, errors.ErrRequestValidationFailed().AddDetailF("time range start must be before end")
+	}
+
+	periods, err := s.db.MeteringPeriods(ctx, from, to)
+	if err != nil {
+		return model.MeteringReport{}, err
+	}
+
+	return meteringReport(periods, from, to, time.Now()), nil
+}
+
+type meteringKey struct {
+	volumeID, nsID, storageName, tariffID string
+}
+
+// meteringReport sums allocated GiB-hours of periods overlapping with time range. Open periods last until now.
+func meteringReport(periods []model.MeteringPeriod, from, to, now time.Time) model.MeteringReport {
+	report := model.MeteringReport{
+		From:        from,
+		To:          to,
+		Records:     make([]model.MeteringRecord, 0),
+		ByTariff:    make(map[string]float64),
+		ByStorage:   make(map[string]float64),
+		ByNamespace: make(map[string]float64),
+	}
+
+	records := make(map[meteringKey]int)
+	for _, period := range periods {
+		start, end := period.StartTime, now
+		if period.EndTime != nil {
+			end = *period.EndTime
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(to) {
+			end = to
+		}
+		if !start.Before(end) {
+			continue
+		}
+		gibHours := period.Capacity.In(model.UnitGiB) * end.Sub(start).Hours()
+
+		key := meteringKey{
+			volumeID:    period.VolumeID,
+			nsID:        period.NamespaceID,
+			storageName: period.StorageName,
+			tariffID:    period.TariffID,
+		}
+		i, ok := records[key]
+		if !ok {
+			i = len(report.Records)
+			records[key] = i
+			report.Records = append(report.Records, model.MeteringRecord{
+				VolumeID:    period.VolumeID,
+				Label:       period.Label,
+				NamespaceID: period.NamespaceID,
+				StorageName: period.StorageName,
+				TariffID:    period.TariffID,
+			})
+		}
+		report.Records[i].GiBHours += gibHours
+		report.ByTariff[period.TariffID] += gibHours
+		report.ByStorage[period.StorageName] += gibHours
+		report.ByNamespace[period.NamespaceID] += gibHours
+		report.Total += gibHours
+	}
+
+	sort.SliceStable(report.Records, func(i, j int) bool {
+		a, b := report.Records[i], report.Records[j]
+		if a.NamespaceID != b.NamespaceID {
+			return a.NamespaceID < b.NamespaceID
+		}
+		return a.VolumeID < b.VolumeID
+	})
+
+	return report
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+func TestMeteringReport(t *testing.T) {
+	from := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(10 * time.Hour)
+	at := func(hours int) *time.Time {
+		ret := from.Add(time.Duration(hours) * time.Hour)
+		return &ret
+	}
+
+	periods := []model.MeteringPeriod{
+		// started before range, resized in the middle
+		{VolumeID: "v1", NamespaceID: "ns1", StorageName: "s1", TariffID: "t1", Capacity: 2 * model.GiB, StartTime: *at(-5), EndTime: at(4)},
+		{VolumeID: "v1", NamespaceID: "ns1", StorageName: "s1", TariffID: "t2", Capacity: 4 * model.GiB, StartTime: *at(4)},
+		// free volume deleted after range end
+		{VolumeID: "v2", NamespaceID: "ns2", StorageName: "s1", TariffID: ZeroUUID, Capacity: model.GiB, StartTime: *at(2), EndTime: at(20)},
+		// deleted before range
+		{VolumeID: "v3", NamespaceID: "ns2", StorageName: "s2", TariffID: "t1", Capacity: model.GiB, StartTime: *at(-5), EndTime: at(-1)},
+	}
+
+	report := meteringReport(periods, from, to, *at(8))
+
+	expected := map[string]float64{
+		"v1/t1":          8,  // 2 GiB * 4h
+		"v1/t2":          16, // 4 GiB * 4h until now
+		"v2/" + ZeroUUID: 8,
+	}
+	if len(report.Records) != len(expected) {
+		t.Fatalf("expected %d records, got %+v", len(expected), report.Records)
+	}
+	for _, rec := range report.Records {
+		if want := expected[rec.VolumeID+"/"+rec.TariffID]; math.Abs(rec.GiBHours-want) > 1e-9 {
+			t.Errorf("volume %s tariff %s: expected %v GiB-hours, got %v", rec.VolumeID, rec.TariffID, want, rec.GiBHours)
+		}
+	}
+	if report.Total != 32 || report.ByStorage["s1"] != 32 || report.ByNamespace["ns2"] != 8 || report.ByTariff["t1"] != 8 {
+		t.Errorf("unexpected totals %+v", report)
+	}
+}
@@ -2,10 +2,13 @@ package server
 
 import (
 	"context"
+	"net/http"
 
+	"git.containerum.net/ch/volume-manager/pkg/models"
 	"github.com/containerum/bill-external/errors"
 	billing "github.com/containerum/bill-external/models"
 	"github.com/containerum/utils/httputil"
+	"github.com/gin-gonic/gin"
 )
 
 // IsAdminRole checks that request came from user with admin permissions.
@@ -27,3 +30,54 @@ func CheckTariff(tariff billing.Tariff, isAdmin bool) error {
 
 	return nil
 }
+
+// SystemContext returns context for background operations which looks like request made by admin.
+// It contains headers required by service clients.
+func SystemContext(parent context.Context) context.Context {
+	return headersContext(parent, ZeroUUID, "admin")
+}
+
+// OwnerContext returns context for billing operations made on behalf of resource owner,
+// so billing attributes them to owner instead of admin or system user running the operation.
+// Request id of parent is kept.
+func OwnerContext(parent context.Context, ownerID string) context.Context {
+	return headersContext(parent, ownerID, "user")
+}
+
+func headersContext(parent context.Context, userID, role string) context.Context {
+	req := (&http.Request{Header: make(http.Header)}).WithContext(parent)
+	if requestID, ok := parent.Value(httputil.RequestIDContextKey).(string); ok {
+		req.Header.Set(httputil.RequestIDXHeader, requestID)
+	}
+	req.Header.Set(httputil.UserIDXHeader, userID)
+	req.Header.Set(httputil.UserRoleXHeader, role)
+
+	gctx := &gin.Context{Request: req}
+	httputil.SaveHeaders(gctx)
+	httputil.PrepareContext(gctx)
+	return gctx.Request.Context()
+}
+
+// detachedContext returns context which is not cancelled with parent, request id of parent is kept
+func detachedContext(parent context.Context) context.Context {
+	ctx := context.Background()
+	if requestID, ok := parent.Value(httputil.RequestIDContextKey).(string); ok {
+		ctx = context.WithValue(ctx, httputil.RequestIDContextKey, requestID)
+	}
+	return ctx
+}
+
+type responseUnitKey struct{}
+
+// WithResponseUnit returns context which asks to represent quantities in responses in unit
+func WithResponseUnit(ctx context.Context, unit model.Unit) context.Context {
+	return context.WithValue(ctx, responseUnitKey{}, unit)
+}
+
+// ResponseUnit returns unit requested by client or default unit
+func ResponseUnit(ctx context.Context) model.Unit {
+	if unit, ok := ctx.Value(responseUnitKey{}).(model.Unit); ok {
+		return unit
+	}
+	return model.DefaultUnit
+}
@@ -0,0 +1,80 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+// pageVolumes selects requested page of volumes matching filter.
+// Next page cursor is returned only for default order by create time and id.
+func (s *Server) pageVolumes(ctx context.Context, filter database.VolumeFilter, req model.PageRequest) ([]model.Volume, model.Pagination, error) {
+	if err := req.Validate(); err != nil {
+		return nil, model.Pagination{}, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+
+	if !req.Paginated() {
+		vols, err := s.db.AllVolumes(ctx, filter)
+		return vols, model.Pagination{Total: len(vols)}, err
+	}
+
+	keyset := len(filter.Sort) == 0
+	if req.Cursor != "" {
+		if !keyset {
+			return nil, model.Pagination{}, errors.ErrRequestValidationFailed().AddDetailF("cursor can not be used with custom sort")
+		}
+		after, err := model.ParseVolumeCursor(req.Cursor)
+		if err != nil {
+			return nil, model.Pagination{}, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+		}
+		filter.After = &after
+	}
+
+	total, err := s.db.CountVolumes(ctx, filter)
+	if err != nil {
+		return nil, model.Pagination{}, err
+	}
+
+	page := model.Pagination{Total: total, PerPage: req.PerPage}
+	var vols []model.Volume
+	var more bool
+	if filter.After != nil {
+		// one extra volume shows if next page exists
+		filter.PerPage = req.PerPage + 1
+		if vols, err = s.db.AllVolumes(ctx, filter); err != nil {
+			return nil, model.Pagination{}, err
+		}
+		if more = len(vols) > req.PerPage; more {
+			vols = vols[:req.PerPage]
+		}
+	} else {
+		page.Page = req.Page
+		if page.Page == 0 {
+			page.Page = 1
+		}
+		filter.Page, filter.PerPage = page.Page, req.PerPage
+		if vols, err = s.db.AllVolumes(ctx, filter); err != nil {
+			return nil, model.Pagination{}, err
+		}
+		more = page.Page*page.PerPage < total
+	}
+
+	if more && keyset && len(vols) > 0 {
+		page.NextCursor = vols[len(vols)-1].Cursor().String()
+	}
+	return vols, page, nil
+}
+
+// pageBounds selects requested page of in-memory list sorted by key
+func pageBounds(n int, key func(i int) string, req model.PageRequest) (from, to int, page model.Pagination, err error) {
+	if err = req.Validate(); err != nil {
+		return 0, 0, page, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+	from, to, page, err = model.PageBounds(n, key, req)
+	if err != nil {
+		return 0, 0, page, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+	return from, to, page, nil
+}
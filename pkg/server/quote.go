@@ -0,0 +1,140 @@
+package server
+
+import (
+	"context"
+	"strings"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/containerum/utils/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+// QuoteVolume returns what volume creation or resize would result in without performing it.
+// Checks which would reject the request are reported as reasons, not as errors.
+// Namespace quota headroom is informational, it does not affect feasibility.
+// Only free volumes are counted against namespace quota, so paid volumes do not change headroom.
+func (s *Server) QuoteVolume(ctx context.Context, nsID string, req model.VolumeQuoteRequest) (model.VolumeQuote, error) {
+	if req.Action == "" {
+		req.Action = model.QuoteCreate
+	}
+	s.log.WithFields(logrus.Fields{
+		"user_id":   httputil.MustGetUserID(ctx),
+		"ns_id":     nsID,
+		"action":    req.Action,
+		"tariff_id": req.TariffID,
+		"label":     req.Label,
+	}).Infof("quote volume")
+
+	quote := model.VolumeQuote{
+		Action:   req.Action,
+		TariffID: req.TariffID,
+	}
+	quote.SetUnit(ResponseUnit(ctx))
+	reject := func(err *cherry.Err) {
+		reason := err.Message
+		if len(err.Details) > 0 {
+			reason += ": " + strings.Join(err.Details, "; ")
+		}
+		quote.Reasons = append(quote.Reasons, reason)
+	}
+
+	nsTariff, err := s.clients.Billing.GetTariffForNamespace(ctx, nsID)
+	if err != nil {
+		return model.VolumeQuote{}, err
+	}
+	quote.QuotaLimit = model.GiBytes(nsTariff.VolumeSize)
+
+	if req.Action == model.QuoteCreate && req.TariffID == ZeroUUID {
+		quote.Capacity = model.GiBytes(nsTariff.VolumeSize)
+		quote.TariffLabel = nsTariff.Label
+	} else {
+		tariff, err := s.clients.Billing.GetVolumeTariff(ctx, req.TariffID)
+		if err != nil {
+			return model.VolumeQuote{}, err
+		}
+		if chkErr := CheckTariff(tariff.Tariff, IsAdminRole(ctx)); chkErr != nil {
+			reject(chkErr.(*cherry.Err))
+		}
+		quote.Capacity = model.GiBytes(tariff.StorageLimit)
+		quote.TariffLabel = tariff.Label
+		quote.Price = tariff.Price
+	}
+
+	if req.Action == model.QuoteCreate {
+		suspensions, err := s.db.AccountSuspensions(ctx, httputil.MustGetUserID(ctx), nsID)
+		if err != nil {
+			return model.VolumeQuote{}, err
+		}
+		if len(suspensions) > 0 {
+			reject(suspendedError(suspensions[0]))
+		}
+	}
+
+	usage, err := s.db.NamespacesQuotaUsage(ctx, nsID)
+	if err != nil {
+		return model.VolumeQuote{}, err
+	}
+	for _, u := range usage {
+		quote.QuotaUsed += u.Capacity
+	}
+
+	var storage model.Storage
+	delta := quote.Capacity
+	switch req.Action {
+	case model.QuoteCreate:
+		_, getErr := s.db.VolumeByLabel(ctx, nsID, req.Label)
+		switch {
+		case getErr == nil:
+			reject(errors.ErrResourceAlreadyExists().AddDetailF("volume %s already exists", req.Label))
+		case !cherry.Equals(getErr, errors.ErrResourceNotExists()):
+			return model.VolumeQuote{}, getErr
+		}
+
+		if quote.Capacity == 0 {
+			reject(errors.ErrQuotaExceeded())
+		}
+
+		storage, err = s.chooseStorage(ctx, req.Storage, quote.Capacity, storageConsumer(ctx, nsID))
+		switch {
+		case err == nil:
+		case cherry.Equals(err, errors.ErrNoFreeStorages()), cherry.Equals(err, errors.ErrResourceNotExists()):
+			reject(err.(*cherry.Err))
+		default:
+			return model.VolumeQuote{}, err
+		}
+	case model.QuoteResize:
+		vol, getErr := s.db.VolumeByLabel(ctx, nsID, req.Label)
+		if getErr != nil {
+			return model.VolumeQuote{}, getErr
+		}
+		quote.CurrentCapacity = vol.Capacity
+		if suspendedErr := volumeSuspendedError(vol); suspendedErr != nil {
+			reject(suspendedErr.(*cherry.Err))
+		}
+		delta = quote.Capacity - vol.Capacity
+		if delta < 0 {
+			reject(errors.ErrDownResize())
+		}
+
+		if storage, err = s.db.StorageByName(ctx, vol.StorageName); err != nil {
+			return model.VolumeQuote{}, err
+		}
+	}
+
+	if storage.Name != "" {
+		quote.StorageName = storage.Name
+		quote.StorageHeadroom = storage.Free() - delta
+		if quote.StorageHeadroom < 0 {
+			reject(errors.ErrNoFreeStorages())
+		}
+	}
+	quote.QuotaHeadroom = quote.QuotaLimit - quote.QuotaUsed
+	if req.TariffID == ZeroUUID {
+		quote.QuotaHeadroom -= delta
+	}
+	quote.Feasible = len(quote.Reasons) == 0
+
+	return quote, nil
+}
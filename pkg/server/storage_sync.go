@@ -0,0 +1,119 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	kubeClientModel "github.com/containerum/kube-client/pkg/model"
+	"github.com/sirupsen/logrus"
+)
+
+func (s *Server) storageClassAllowed(name string) bool {
+	if len(s.storageClassesAllowList) == 0 {
+		return true
+	}
+	for _, allowed := range s.storageClassesAllowList {
+		if allowed == name {
+			return true
+		}
+	}
+	return false
+}
+
+// SyncStorages creates, updates or deletes storages to match storage classes from kube backend.
+// Only storages allowed by storage classes allow list are affected.
+// Only storages created by sync are deleted, so storages registered by hand survive missing classes.
+func (s *Server) SyncStorages(ctx context.Context, dryRun bool) (model.StorageSyncReport, error) {
+	s.log.WithField("dry_run", dryRun).Infof("sync storages with storage classes")
+
+	ret := model.StorageSyncReport{
+		DryRun:  dryRun,
+		Created: make([]string, 0),
+		Updated: make([]string, 0),
+		Deleted: make([]string, 0),
+		Failed:  make([]kubeClientModel.ImportResult, 0),
+	}
+
+	// storage class is taken from cluster owning storage with the same name
+	var classes []model.StorageClass
+	for _, cluster := range s.clients.Kube.Clusters() {
+		clusterClasses, err := cluster.Client.GetStorageClasses(ctx)
+		if err != nil {
+			return ret, err
+		}
+		for _, class := range clusterClasses {
+			if s.clients.Kube.StorageCluster(class.Name).Name == cluster.Name {
+				classes = append(classes, class)
+			}
+		}
+	}
+
+	storages, err := s.db.AllStorages(ctx)
+	if err != nil {
+		return ret, err
+	}
+
+	storagesByName := make(map[string]model.Storage, len(storages))
+	for _, storage := range storages {
+		storagesByName[storage.Name] = storage
+	}
+
+	failed := func(name string, err error) {
+		s.log.WithError(err).WithField("name", name).Warnf("storage sync failed")
+		ret.Failed = append(ret.Failed, kubeClientModel.ImportResult{Name: name, Message: err.Error()})
+	}
+
+	classNames := make(map[string]bool, len(classes))
+	for _, class := range classes {
+		if !s.storageClassAllowed(class.Name) {
+			continue
+		}
+		classNames[class.Name] = true
+
+		storage, exists := storagesByName[class.Name]
+		switch {
+		case !exists:
+			size := class.Capacity
+			if size <= 0 {
+				size = model.DefaultImportStorageSize
+			}
+			if !dryRun {
+				if createErr := s.CreateStorage(ctx, model.Storage{Name: class.Name, Size: size, Attributes: class.Parameters, Synced: true}); createErr != nil {
+					failed(class.Name, createErr)
+					continue
+				}
+			}
+			ret.Created = append(ret.Created, class.Name)
+		case class.Capacity > 0 && class.Capacity != storage.Size:
+			if !dryRun {
+				if updErr := s.UpdateStorage(ctx, class.Name, model.UpdateStorageRequest{Size: &class.Capacity}); updErr != nil {
+					failed(class.Name, updErr)
+					continue
+				}
+			}
+			ret.Updated = append(ret.Updated, class.Name)
+		}
+	}
+
+	for _, storage := range storages {
+		if !storage.Synced || storage.Deleted || classNames[storage.Name] || !s.storageClassAllowed(storage.Name) {
+			continue
+		}
+		if !dryRun {
+			if delErr := s.DeleteStorage(ctx, storage.Name); delErr != nil {
+				failed(storage.Name, delErr)
+				continue
+			}
+		}
+		ret.Deleted = append(ret.Deleted, storage.Name)
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"created": len(ret.Created),
+		"updated": len(ret.Updated),
+		"deleted": len(ret.Deleted),
+		"failed":  len(ret.Failed),
+	}).Infof("storages synchronized")
+
+	return ret, nil
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"context"
+	"testing"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+func TestSyncStoragesKeepsManualStorages(t *testing.T) {
+	db := newMemoryDB(
+		model.Storage{Name: "manual", Size: 10 * model.GiB},
+		model.Storage{Name: "synced", Size: 10 * model.GiB, Synced: true},
+	)
+	// dummy backend has no storage classes, allow list is empty
+	s := NewServer(db, &Clients{Kube: clients.SingleKubeCluster("default", clients.NewKubeAPIDummyClient())})
+
+	report, err := s.SyncStorages(context.Background(), false)
+	if err != nil {
+		t.Fatalf("sync failed: %v", err)
+	}
+	if len(report.Deleted) != 1 || report.Deleted[0] != "synced" {
+		t.Errorf("expected only synced storage deleted, got %v", report.Deleted)
+	}
+	if db.storages["manual"].Deleted {
+		t.Errorf("manually registered storage deleted")
+	}
+	if !db.storages["synced"].Deleted {
+		t.Errorf("synced storage without class not deleted")
+	}
+}
@@ -2,16 +2,27 @@ package server
 
 import (
 	"context"
+	"sort"
 
 	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
 	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/containerum/utils/httputil"
+	"github.com/sirupsen/logrus"
 )
 
 type StorageActions interface {
 	CreateStorage(ctx context.Context, storage model.Storage) error
-	GetStorages(ctx context.Context) ([]model.Storage, error)
+	ImportStorage(ctx context.Context, req model.StorageImportRequest, dryRun bool) (updated bool, err error)
+	GetStorages(ctx context.Context, page model.PageRequest) ([]model.Storage, model.Pagination, error)
+	GetNamespaceStorages(ctx context.Context, nsID string, page model.PageRequest) ([]model.Storage, model.Pagination, error)
+	GetStorage(ctx context.Context, name string, page model.PageRequest) (model.StorageDetails, error)
 	UpdateStorage(ctx context.Context, name string, req model.UpdateStorageRequest) error
 	DeleteStorage(ctx context.Context, name string) error
+	RecalculateStoragesUsage(ctx context.Context, dryRun bool) (model.StorageUsageReport, error)
+	SyncStorages(ctx context.Context, dryRun bool) (model.StorageSyncReport, error)
+	GetStorageForecast(ctx context.Context, name string, days int) (model.StorageForecast, error)
 }
 
 func (s *Server) CreateStorage(ctx context.Context, storage model.Storage) error {
@@ -23,13 +34,151 @@ func (s *Server) CreateStorage(ctx context.Context, storage model.Storage) error
 	return err
 }
 
-func (s *Server) GetStorages(ctx context.Context) ([]model.Storage, error) {
-	s.log.Infof("get storages")
+// ImportStorage creates storage or updates existing one with the same name.
+// In dry run mode storage is only checked and nothing is written.
+func (s *Server) ImportStorage(ctx context.Context, req model.StorageImportRequest, dryRun bool) (updated bool, err error) {
+	s.log.WithFields(logrus.Fields{
+		"name":    req.Name,
+		"size":    req.Size.String(),
+		"dry_run": dryRun,
+	}).Infof("import storage")
+
+	if err = req.Validate(); err != nil {
+		return false, err
+	}
+
+	err = s.db.Transactional(func(tx database.DB) error {
+		storage, getErr := tx.StorageByName(ctx, req.Name)
+		switch {
+		case getErr == nil:
+			updated = true
+		case cherry.Equals(getErr, errors.ErrResourceNotExists()):
+			storage = model.Storage{Name: req.Name}
+		default:
+			return getErr
+		}
+
+		storage.Size = req.Size
+		if req.Used != nil {
+			storage.Used = *req.Used
+		}
+		if req.Attributes != nil {
+			storage.Attributes = req.Attributes
+		}
+		if storage.Size < storage.Used {
+			return errors.ErrQuotaExceeded().AddDetailF("storage quota exceeded (%s)", storage.Used-storage.Size)
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		if !updated {
+			if createErr := tx.CreateStorage(ctx, &storage); createErr != nil {
+				return createErr
+			}
+		}
+		if req.Used != nil {
+			if setErr := tx.SetStorageUsed(ctx, storage.Name, *req.Used); setErr != nil {
+				return setErr
+			}
+		}
+		if updated {
+			return tx.UpdateStorage(ctx, storage.Name, storage)
+		}
+		return nil
+	})
+
+	return updated, err
+}
+
+func (s *Server) GetStorages(ctx context.Context, page model.PageRequest) ([]model.Storage, model.Pagination, error) {
+	s.log.WithField("page", page).Infof("get storages")
 	storages, err := s.db.AllStorages(ctx)
-	if err == nil && storages == nil {
-		storages = make([]model.Storage, 0)
+	if err != nil {
+		return nil, model.Pagination{}, err
 	}
-	return storages, err
+	return pageStorages(ctx, storages, page)
+}
+
+// GetNamespaceStorages returns storages where user can place volumes of namespace
+func (s *Server) GetNamespaceStorages(ctx context.Context, nsID string, page model.PageRequest) ([]model.Storage, model.Pagination, error) {
+	consumer := storageConsumer(ctx, nsID)
+	s.log.WithFields(logrus.Fields{
+		"ns_id":   nsID,
+		"user_id": consumer.UserID,
+		"page":    page,
+	}).Infof("get namespace storages")
+
+	storages, err := s.db.AllStorages(ctx)
+	if err != nil {
+		return nil, model.Pagination{}, err
+	}
+
+	allowed := make([]model.Storage, 0, len(storages))
+	for _, storage := range storages {
+		if storage.Allows(consumer) {
+			allowed = append(allowed, storage)
+		}
+	}
+	return pageStorages(ctx, allowed, page)
+}
+
+// pageStorages selects page of storages ordered by name
+func pageStorages(ctx context.Context, storages []model.Storage, page model.PageRequest) ([]model.Storage, model.Pagination, error) {
+	sort.Slice(storages, func(i, j int) bool { return storages[i].Name < storages[j].Name })
+	from, to, pagination, err := pageBounds(len(storages), func(i int) string { return storages[i].Name }, page)
+	if err != nil {
+		return nil, pagination, err
+	}
+
+	ret := make([]model.Storage, 0, to-from)
+	for _, storage := range storages[from:to] {
+		storage.SetUnit(ResponseUnit(ctx))
+		ret = append(ret, storage)
+	}
+	return ret, pagination, nil
+}
+
+func (s *Server) GetStorage(ctx context.Context, name string, page model.PageRequest) (model.StorageDetails, error) {
+	s.log.WithFields(logrus.Fields{
+		"name": name,
+		"page": page,
+	}).Infof("get storage")
+
+	storage, err := s.db.StorageByName(ctx, name)
+	if err != nil {
+		return model.StorageDetails{}, err
+	}
+
+	filter := StandardVolumeFilter
+	filter.StorageName = storage.Name
+	vols, pagination, err := s.pageVolumes(ctx, filter, page)
+	if err != nil {
+		return model.StorageDetails{}, err
+	}
+
+	usage, err := s.db.StorageUsageByNamespace(ctx, storage.Name)
+	if err != nil {
+		return model.StorageDetails{}, err
+	}
+
+	storage.Volumes = make([]*model.Volume, len(vols))
+	for i := range vols {
+		storage.Volumes[i] = &vols[i]
+	}
+
+	ret := model.StorageDetails{
+		Storage:           storage,
+		Namespaces:        usage,
+		VolumesPagination: pagination,
+	}
+	for _, nsUsage := range usage {
+		ret.VolumesTotal += nsUsage.Volumes
+	}
+	ret.SetUnit(ResponseUnit(ctx))
+
+	return ret, nil
 }
 
 func (s *Server) UpdateStorage(ctx context.Context, name string, req model.UpdateStorageRequest) error {
@@ -46,6 +195,12 @@ func (s *Server) UpdateStorage(ctx context.Context, name string, req model.Updat
 		if req.Size != nil {
 			storage.Size = *req.Size
 		}
+		if req.Attributes != nil {
+			storage.Attributes = req.Attributes
+		}
+		if req.Access != nil {
+			storage.StorageAccess = *req.Access
+		}
 
 		return tx.UpdateStorage(ctx, name, storage)
 	})
@@ -62,3 +217,72 @@ func (s *Server) DeleteStorage(ctx context.Context, name string) error {
 		return tx.DeleteStorage(ctx, &storage)
 	})
 }
+
+// RecalculateStoragesUsage compares recorded storages usage with total capacity of live volumes.
+// If dryRun is false, recorded usage is replaced with actual one.
+func (s *Server) RecalculateStoragesUsage(ctx context.Context, dryRun bool) (model.StorageUsageReport, error) {
+	s.log.WithField("dry_run", dryRun).Infof("recalculate storages usage")
+
+	ret := model.StorageUsageReport{
+		DryRun:        dryRun,
+		Discrepancies: make([]model.StorageUsageCheck, 0),
+	}
+
+	err := s.db.Transactional(func(tx database.DB) error {
+		checks, err := tx.StoragesUsageCheck(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, check := range checks {
+			if check.Recorded == check.Actual {
+				continue
+			}
+
+			s.log.WithFields(logrus.Fields{
+				"name":     check.Name,
+				"recorded": check.Recorded.String(),
+				"actual":   check.Actual.String(),
+			}).Warnf("storage usage mismatch")
+			ret.Discrepancies = append(ret.Discrepancies, check)
+
+			if dryRun {
+				continue
+			}
+			if setErr := tx.SetStorageUsed(ctx, check.Name, check.Actual); setErr != nil {
+				return setErr
+			}
+		}
+
+		return nil
+	})
+	ret.SetUnit(ResponseUnit(ctx))
+
+	return ret, err
+}
+
+func storageConsumer(ctx context.Context, nsID string) model.StorageConsumer {
+	role, _ := ctx.Value(httputil.UserRoleContextKey).(string)
+	return model.StorageConsumer{
+		NamespaceID: nsID,
+		UserID:      httputil.MustGetUserID(ctx),
+		Role:        role,
+	}
+}
+
+// chooseStorage returns storage with provided name or least used storage with enough free space.
+// Storages not accessible by consumer treated as not existing.
+func (s *Server) chooseStorage(ctx context.Context, name string, size model.Quantity, consumer model.StorageConsumer) (model.Storage, error) {
+	if name == "" {
+		return s.db.LeastUsedStorage(ctx, size, consumer)
+	}
+
+	storage, err := s.db.StorageByName(ctx, name)
+	if err != nil {
+		return model.Storage{}, err
+	}
+	if !storage.Allows(consumer) {
+		return model.Storage{}, errors.ErrResourceNotExists().AddDetailF("storage %s not exists", name)
+	}
+	return storage, nil
+}
@@ -0,0 +1,208 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/sirupsen/logrus"
+)
+
+// SuspensionActions manages suspended users and namespaces
+type SuspensionActions interface {
+	GetSuspensions(ctx context.Context, page model.PageRequest) ([]model.Suspension, model.Pagination, error)
+	Suspend(ctx context.Context, req model.SuspensionRequest) (model.SuspensionReport, error)
+	Resume(ctx context.Context, kind model.SuspensionKind, id string) (model.SuspensionReport, error)
+	HandleBillingAccountEvent(ctx context.Context, event model.BillingAccountEvent) (model.SuspensionReport, error)
+}
+
+// GetSuspensions returns page of suspensions ordered by create time
+func (s *Server) GetSuspensions(ctx context.Context, page model.PageRequest) ([]model.Suspension, model.Pagination, error) {
+	s.log.WithField("page", page).Infof("get suspensions")
+
+	suspensions, err := s.db.Suspensions(ctx)
+	if err != nil {
+		return nil, model.Pagination{}, err
+	}
+	sort.Slice(suspensions, func(i, j int) bool { return suspensions[i].SortKey() < suspensions[j].SortKey() })
+	from, to, pagination, err := pageBounds(len(suspensions), func(i int) string { return suspensions[i].SortKey() }, page)
+	if err != nil {
+		return nil, pagination, err
+	}
+	return suspensions[from:to], pagination, nil
+}
+
+// Suspend marks user or namespace as suspended, so its volumes can not be created or resized.
+// Volumes are detached from workloads in kube. Suspension is rejected as not supported
+// if kube backend of any volume can not suspend it, so volumes do not stay attached silently.
+func (s *Server) Suspend(ctx context.Context, req model.SuspensionRequest) (model.SuspensionReport, error) {
+	return s.suspend(ctx, model.Suspension{
+		Kind:   req.Kind,
+		ID:     req.ID,
+		Source: model.SuspendedByAdmin,
+		Reason: req.Reason,
+	})
+}
+
+// HandleBillingAccountEvent suspends blocked and resumes unblocked billing accounts
+func (s *Server) HandleBillingAccountEvent(ctx context.Context, event model.BillingAccountEvent) (model.SuspensionReport, error) {
+	switch event.Event {
+	case model.AccountBlocked:
+		return s.suspend(ctx, model.Suspension{
+			Kind:   event.Kind,
+			ID:     event.ID,
+			Source: model.SuspendedByBilling,
+			Reason: event.Reason,
+		})
+	case model.AccountUnblocked:
+		return s.Resume(ctx, event.Kind, event.ID)
+	default:
+		return model.SuspensionReport{}, errors.ErrRequestValidationFailed().AddDetailF("unknown event %q", event.Event)
+	}
+}
+
+func (s *Server) suspend(ctx context.Context, suspension model.Suspension) (model.SuspensionReport, error) {
+	s.log.WithFields(logrus.Fields{
+		"kind":   suspension.Kind,
+		"id":     suspension.ID,
+		"source": suspension.Source,
+		"reason": suspension.Reason,
+	}).Infof("suspend")
+
+	vols, err := s.accountVolumes(ctx, suspension)
+	if err != nil {
+		return model.SuspensionReport{}, err
+	}
+	if err := s.checkKubeSuspendable(vols); err != nil {
+		return model.SuspensionReport{}, err
+	}
+
+	err = s.db.Transactional(func(tx database.DB) error {
+		return tx.SaveSuspension(ctx, &suspension)
+	})
+	if err != nil {
+		return model.SuspensionReport{}, err
+	}
+
+	return s.setVolumesSuspended(ctx, suspension, vols, true)
+}
+
+// Resume removes suspension and unsuspends volumes if they are not suspended by other suspension
+func (s *Server) Resume(ctx context.Context, kind model.SuspensionKind, id string) (model.SuspensionReport, error) {
+	s.log.WithFields(logrus.Fields{
+		"kind": kind,
+		"id":   id,
+	}).Infof("resume")
+
+	suspension := model.Suspension{Kind: kind, ID: id}
+	err := s.db.Transactional(func(tx database.DB) error {
+		return tx.DeleteSuspension(ctx, &suspension)
+	})
+	if err != nil {
+		return model.SuspensionReport{}, err
+	}
+
+	vols, err := s.accountVolumes(ctx, suspension)
+	if err != nil {
+		return model.SuspensionReport{}, err
+	}
+	return s.setVolumesSuspended(ctx, suspension, vols, false)
+}
+
+func (s *Server) accountVolumes(ctx context.Context, suspension model.Suspension) ([]model.Volume, error) {
+	switch suspension.Kind {
+	case model.SuspendedUser:
+		return s.db.UserVolumes(ctx, suspension.ID)
+	case model.SuspendedNamespace:
+		return s.db.NamespaceVolumes(ctx, suspension.ID)
+	default:
+		return nil, nil
+	}
+}
+
+// setVolumesSuspended changes volumes one by one, so failure of one volume does not revert others.
+// Failed volumes are reported and can be fixed by repeating request.
+func (s *Server) setVolumesSuspended(ctx context.Context, suspension model.Suspension, vols []model.Volume, suspended bool) (model.SuspensionReport, error) {
+	ret := model.SuspensionReport{
+		Suspension: suspension,
+		Volumes:    make([]model.SuspendedVolume, 0),
+	}
+
+	for _, vol := range vols {
+		result := model.SuspendedVolume{
+			NamespaceID: vol.NamespaceID,
+			Label:       vol.Label,
+			Suspended:   vol.Suspended,
+		}
+		if vol.Suspended != suspended {
+			changed, err := s.setVolumeSuspended(ctx, vol, suspended)
+			switch {
+			case err != nil:
+				s.log.WithError(err).WithFields(logrus.Fields{
+					"ns_id": vol.NamespaceID,
+					"label": vol.Label,
+				}).Warnf("volume suspension change failed")
+				result.Error = err.Error()
+			case changed:
+				result.Suspended = suspended
+			}
+		}
+		ret.Volumes = append(ret.Volumes, result)
+	}
+
+	return ret, nil
+}
+
+// setVolumeSuspended changes volume state in database and kube. Volume is not resumed if its owner or namespace is still suspended.
+func (s *Server) setVolumeSuspended(ctx context.Context, vol model.Volume, suspended bool) (changed bool, err error) {
+	err = s.db.Transactional(func(tx database.DB) error {
+		if !suspended {
+			suspensions, getErr := tx.AccountSuspensions(ctx, vol.OwnerUserID, vol.NamespaceID)
+			if getErr != nil {
+				return getErr
+			}
+			if len(suspensions) > 0 {
+				return nil
+			}
+		}
+
+		vol.Suspended = suspended
+		if updErr := tx.UpdateVolume(ctx, &vol); updErr != nil {
+			return updErr
+		}
+
+		if kubeErr := s.kubeSetVolumeSuspended(ctx, vol, suspended); kubeErr != nil {
+			return kubeErr
+		}
+
+		changed = true
+		return nil
+	})
+	return changed, err
+}
+
+// checkNotSuspended returns error if user or namespace is suspended
+func (s *Server) checkNotSuspended(ctx context.Context, userID, nsID string) error {
+	suspensions, err := s.db.AccountSuspensions(ctx, userID, nsID)
+	if err != nil {
+		return err
+	}
+	if len(suspensions) > 0 {
+		return suspendedError(suspensions[0])
+	}
+	return nil
+}
+
+func suspendedError(suspension model.Suspension) *cherry.Err {
+	return errors.ErrSuspended().AddDetailF("%s %s is suspended", suspension.Kind, suspension.ID)
+}
+
+func volumeSuspendedError(vol model.Volume) error {
+	if vol.Suspended {
+		return errors.ErrSuspended().AddDetailF("volume %s is suspended", vol.Label)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+)
+
+// recordingSuspender records volumes detached in kube. Other methods panic on embedded nil interface.
+type recordingSuspender struct {
+	clients.KubeAPIClient
+
+	suspended map[string]bool
+}
+
+func (k *recordingSuspender) SuspendVolume(ctx context.Context, namespace, volumeName string) error {
+	k.suspended[namespace+"/"+volumeName] = true
+	return nil
+}
+
+func (k *recordingSuspender) ResumeVolume(ctx context.Context, namespace, volumeName string) error {
+	delete(k.suspended, namespace+"/"+volumeName)
+	return nil
+}
+
+func TestSuspendVolumeDetachesKubeVolume(t *testing.T) {
+	now := time.Now()
+	vol := model.Volume{
+		Resource:    model.Resource{ID: "vol-id", Label: "vol", OwnerUserID: "alice", CreateTime: &now},
+		NamespaceID: "ns",
+		Capacity:    model.GiBytes(1),
+	}
+	db := newMemoryDB()
+	db.volumes = []model.Volume{vol}
+	kube := &recordingSuspender{suspended: make(map[string]bool)}
+	s := NewServer(db, &Clients{Kube: clients.SingleKubeCluster("default", kube)})
+
+	changed, err := s.setVolumeSuspended(context.Background(), vol, true)
+	if err != nil || !changed {
+		t.Fatalf("expected volume to be suspended, got changed %v, error %v", changed, err)
+	}
+	if !db.volumes[0].Suspended {
+		t.Errorf("expected suspended volume in db")
+	}
+	if !kube.suspended["ns/vol"] {
+		t.Errorf("expected volume to be suspended in kube")
+	}
+}
+
+func TestSuspendNotSupportedByKubeBackend(t *testing.T) {
+	vols := []model.Volume{{Resource: model.Resource{Label: "vol"}, NamespaceID: "ns"}}
+	s := NewServer(newMemoryDB(), &Clients{Kube: clients.SingleKubeCluster("default", new(clients.KubeAPIHTTPClient))})
+
+	if err := s.checkKubeSuspendable(vols); !cherry.Equals(err, errors.ErrNotSupported()) {
+		t.Errorf("expected not supported error, got %v", err)
+	}
+}
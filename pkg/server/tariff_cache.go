@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/sirupsen/logrus"
+)
+
+type tariffCache interface {
+	InvalidateTariffs(tariffIDs, nsIDs []string)
+	TariffCacheStats() model.TariffCacheStats
+}
+
+// TariffCacheStats returns billing tariffs cache statistics. Stats of disabled cache contain only Enabled field.
+func (s *Server) TariffCacheStats(ctx context.Context) model.TariffCacheStats {
+	if cache, ok := s.clients.Billing.(tariffCache); ok {
+		return cache.TariffCacheStats()
+	}
+	return model.TariffCacheStats{}
+}
+
+// InvalidateTariffCache removes given volume and namespace tariffs from cache. Whole cache is purged if no ids provided.
+func (s *Server) InvalidateTariffCache(ctx context.Context, tariffIDs, nsIDs []string) model.TariffCacheStats {
+	s.log.WithFields(logrus.Fields{
+		"tariff_ids": tariffIDs,
+		"ns_ids":     nsIDs,
+	}).Infof("invalidate tariff cache")
+
+	if cache, ok := s.clients.Billing.(tariffCache); ok {
+		cache.InvalidateTariffs(tariffIDs, nsIDs)
+		return cache.TariffCacheStats()
+	}
+	return model.TariffCacheStats{}
+}
@@ -0,0 +1,167 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	billing "github.com/containerum/bill-external/models"
+	"github.com/sirupsen/logrus"
+)
+
+// MigrateTariff moves all live volumes from one tariff to another in batches.
+// Volumes which would be down resized are skipped. Each volume is migrated in own transaction:
+// its capacity is updated in database, billing subscription tariff is changed and volume is resized in kube.
+func (s *Server) MigrateTariff(ctx context.Context, req model.TariffMigrationRequest) (model.TariffMigrationReport, error) {
+	if req.BatchSize <= 0 {
+		req.BatchSize = model.DefaultTariffMigrationBatchSize
+	}
+	entry := s.log.WithFields(logrus.Fields{
+		"from_tariff_id": req.FromTariffID,
+		"to_tariff_id":   req.ToTariffID,
+		"batch_size":     req.BatchSize,
+		"dry_run":        req.DryRun,
+	})
+	entry.Infof("migrate tariff")
+
+	ret := model.TariffMigrationReport{
+		FromTariffID: req.FromTariffID,
+		ToTariffID:   req.ToTariffID,
+		DryRun:       req.DryRun,
+		Results:      make([]model.TariffMigrationResult, 0),
+	}
+
+	if req.FromTariffID == ZeroUUID {
+		return ret, errors.ErrRequestValidationFailed().AddDetailF("free volumes can not be migrated")
+	}
+
+	newTariff, err := s.clients.Billing.GetVolumeTariff(ctx, req.ToTariffID)
+	if err != nil {
+		return ret, err
+	}
+	if chkErr := CheckTariff(newTariff.Tariff, true); chkErr != nil {
+		return ret, chkErr
+	}
+	newCapacity := model.GiBytes(newTariff.StorageLimit)
+
+	filter := StandardVolumeFilter
+	filter.TariffID = req.FromTariffID
+	vols, err := s.db.AllVolumes(ctx, filter)
+	if err != nil {
+		return ret, err
+	}
+
+	for start := 0; start < len(vols); start += req.BatchSize {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ret, ctxErr
+		}
+
+		end := start + req.BatchSize
+		if end > len(vols) {
+			end = len(vols)
+		}
+
+		var migrated []model.Volume
+		for _, vol := range vols[start:end] {
+			result := model.TariffMigrationResult{
+				ID:          vol.ID,
+				NamespaceID: vol.NamespaceID,
+				Label:       vol.Label,
+				OldCapacity: vol.Capacity,
+				NewCapacity: newCapacity,
+			}
+
+			if req.DryRun {
+				err = checkMigration(vol, newCapacity)
+			} else {
+				oldVol := vol
+				if vol, err = s.migrateVolume(ctx, vol, req.FromTariffID, newTariff); err == nil {
+					s.volumeResized(ctx, oldVol, vol)
+				}
+			}
+			if err == nil {
+				result.Migrated = true
+				ret.Migrated++
+				migrated = append(migrated, vol)
+			} else {
+				result.Error = err.Error()
+				ret.Failed++
+			}
+			ret.Results = append(ret.Results, result)
+		}
+		ret.Batches++
+
+		if !req.DryRun {
+			s.capacityChanged(ctx, migrated...)
+		}
+		entry.WithFields(logrus.Fields{
+			"batch":    ret.Batches,
+			"migrated": ret.Migrated,
+			"failed":   ret.Failed,
+			"total":    len(vols),
+		}).Infof("tariff migration batch done")
+	}
+
+	return ret, nil
+}
+
+func checkMigration(vol model.Volume, newCapacity model.Quantity) error {
+	if err := volumeSuspendedError(vol); err != nil {
+		return err
+	}
+	if newCapacity < vol.Capacity {
+		return errors.ErrDownResize()
+	}
+	return nil
+}
+
+func (s *Server) migrateVolume(ctx context.Context, vol model.Volume, fromTariffID string, newTariff billing.VolumeTariff) (model.Volume, error) {
+	err := s.db.Transactional(func(tx database.DB) error {
+		var getErr error
+		if vol, getErr = tx.VolumeByLabel(ctx, vol.NamespaceID, vol.Label); getErr != nil {
+			return getErr
+		}
+		// volume could be changed after it was listed
+		if vol.TariffID == nil || *vol.TariffID != fromTariffID {
+			return errors.ErrResourceNotExists().AddDetailF("volume %s is not on tariff %s anymore", vol.Label, fromTariffID)
+		}
+
+		newCapacity := model.GiBytes(newTariff.StorageLimit)
+		if chkErr := checkMigration(vol, newCapacity); chkErr != nil {
+			return chkErr
+		}
+
+		vol.TariffID = &newTariff.ID
+		vol.Capacity = newCapacity
+		if updErr := tx.UpdateVolume(ctx, &vol); updErr != nil {
+			return updErr
+		}
+
+		// subscription belongs to volume owner, not to admin running migration
+		billingCtx := OwnerContext(ctx, vol.OwnerUserID)
+		if changeErr := s.clients.Billing.ChangeTariff(billingCtx, vol.ID, newTariff.ID); changeErr != nil {
+			return changeErr
+		}
+
+		if kubeErr := s.kubeUpdateVolume(ctx, vol); kubeErr != nil {
+			s.restoreTariff(billingCtx, vol.ID, fromTariffID)
+			return kubeErr
+		}
+
+		return nil
+	})
+
+	return vol, err
+}
+
+// restoreTariff changes volume subscription back to old tariff after failed migration.
+// Context must carry volume owner headers. Failure is only logged, mismatch will be found by billing reconciliation.
+func (s *Server) restoreTariff(ctx context.Context, resourceID, tariffID string) {
+	if err := s.clients.Billing.ChangeTariff(ctx, resourceID, tariffID); err != nil {
+		s.log.WithError(err).WithFields(logrus.Fields{
+			"resource_id": resourceID,
+			"tariff_id":   tariffID,
+		}).Errorf("restoring subscription tariff failed")
+	}
+}
@@ -0,0 +1,47 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	billing "github.com/containerum/bill-external/models"
+	"github.com/containerum/utils/httputil"
+)
+
+func TestMigrateVolumeChangesOwnerTariff(t *testing.T) {
+	oldTariff := "old-tariff"
+	now := time.Now()
+	db := newMemoryDB()
+	db.volumes = []model.Volume{{
+		Resource:    model.Resource{ID: "vol-id", Label: "vol", OwnerUserID: "alice", TariffID: &oldTariff, CreateTime: &now},
+		NamespaceID: "ns",
+		Capacity:    model.GiBytes(1),
+	}}
+	bill := newRecordingBilling()
+	s := NewServer(db, &Clients{
+		Billing: bill,
+		Kube:    clients.SingleKubeCluster("default", clients.NewKubeAPIDummyClient()),
+	})
+
+	adminCtx := OwnerContext(context.Background(), "admin")
+	newTariff := billing.VolumeTariff{ID: "new-tariff", StorageLimit: 2}
+	vol, err := s.migrateVolume(adminCtx, db.volumes[0], oldTariff, newTariff)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vol.Capacity != model.GiBytes(2) || *vol.TariffID != newTariff.ID {
+		t.Errorf("unexpected migrated volume %+v", vol)
+	}
+	if change := bill.tariffChanges["vol-id"]; change != "alice:new-tariff" {
+		t.Errorf("expected subscription tariff changed for owner, got %q", change)
+	}
+	if len(bill.unsubscribed) != 0 || len(bill.subscribedBy) != 0 {
+		t.Errorf("expected subscription to be kept, got unsubscribed %v, subscribed %v", bill.unsubscribed, bill.subscribedBy)
+	}
+	if user := httputil.MustGetUserID(adminCtx); user != "admin" {
+		t.Errorf("caller context changed: %q", user)
+	}
+}
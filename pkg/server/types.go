@@ -4,16 +4,19 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+	"strings"
+	"sync"
 
 	"git.containerum.net/ch/volume-manager/pkg/clients"
 	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/webhooks"
 	"github.com/containerum/cherry/adaptors/cherrylog"
 	"github.com/sirupsen/logrus"
 )
 
 type Clients struct {
 	Billing clients.BillingClient
-	KubeAPI clients.KubeAPIClient
+	Kube    *clients.KubeClusters
 }
 
 func (c *Clients) Close() error {
@@ -33,16 +36,67 @@ func (c *Clients) Close() error {
 	return nil
 }
 
+// StatusDetails returns circuit breaker states of service clients
+func (c *Clients) StatusDetails() map[string]string {
+	ret := make(map[string]string)
+	rval := reflect.ValueOf(c).Elem()
+	for i := 0; i < rval.NumField(); i++ {
+		if reporter, ok := rval.Field(i).Interface().(interface{ BreakerState() clients.BreakerState }); ok {
+			ret[strings.ToLower(rval.Type().Field(i).Name)+"_breaker"] = string(reporter.BreakerState())
+		}
+	}
+	if c.Kube != nil {
+		for cluster, state := range c.Kube.BreakerStates() {
+			ret["kube_"+cluster+"_breaker"] = string(state)
+		}
+	}
+	return ret
+}
+
 type Server struct {
 	clients *Clients
 	db      database.DB
 	log     *cherrylog.LogrusAdapter
+
+	storageClassesAllowList []string
+
+	capacityAlerts *capacityAlerts
+
+	webhookSender             webhooks.Sender
+	storageNearFullThresholds capacityThresholds
+
+	// capacity checks started by requests
+	background sync.WaitGroup
+}
+
+// Option configures optional server features
+type Option func(s *Server)
+
+// WithStorageClassesAllowList limits storage classes synchronized with storages. Empty list allows all classes.
+func WithStorageClassesAllowList(names ...string) Option {
+	return func(s *Server) {
+		s.storageClassesAllowList = names
+	}
 }
 
-func NewServer(db database.DB, clients *Clients) *Server {
-	return &Server{
+func NewServer(db database.DB, clients *Clients, opts ...Option) *Server {
+	s := &Server{
 		db:      db,
 		log:     cherrylog.NewLogrusAdapter(logrus.WithField("component", "volume_manager")),
 		clients: clients,
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Wait blocks until capacity checks started by requests are finished
+func (s *Server) Wait() {
+	s.background.Wait()
+}
+
+// StatusDetails returns details for service status endpoint
+func (s *Server) StatusDetails() map[string]string {
+	return s.clients.StatusDetails()
 }
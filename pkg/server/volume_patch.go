@@ -0,0 +1,159 @@
+package server
+
+import (
+	"context"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	billing "github.com/containerum/bill-external/models"
+	"github.com/containerum/cherry"
+	"github.com/containerum/utils/httputil"
+	"github.com/sirupsen/logrus"
+)
+
+// PatchVolume applies merge patch to volume. All changes are applied in one transaction,
+// changes made in billing and kubernetes are reverted where possible if later step fails.
+func (s *Server) PatchVolume(ctx context.Context, nsID, label string, patch model.VolumePatch) (model.VolumeResponse, error) {
+	userID := httputil.MustGetUserID(ctx)
+	s.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"ns_id":   nsID,
+		"label":   label,
+	}).Infof("patch volume")
+
+	if patch.Capacity != nil && !IsAdminRole(ctx) {
+		return model.VolumeResponse{}, errors.ErrAdminRequired().AddDetailF("only admins can set capacity")
+	}
+
+	var tariff billing.VolumeTariff
+	if patch.TariffID != nil {
+		var err error
+		if tariff, err = s.volumeTariff(ctx, *patch.TariffID); err != nil {
+			return model.VolumeResponse{}, err
+		}
+	}
+
+	var oldVol, vol model.Volume
+	err := s.db.Transactional(func(tx database.DB) error {
+		var getErr error
+		if vol, getErr = tx.VolumeByLabel(ctx, nsID, label); getErr != nil {
+			return getErr
+		}
+		oldVol = vol
+
+		if checkErr := s.checkKubePatch(vol, patch); checkErr != nil {
+			return checkErr
+		}
+
+		if patch.ChangesKube() {
+			if suspendedErr := volumeSuspendedError(vol); suspendedErr != nil {
+				return suspendedErr
+			}
+		}
+
+		switch {
+		case patch.TariffID != nil:
+			if resizeErr := resizeVolume(&vol, model.GiBytes(tariff.StorageLimit), &tariff.ID); resizeErr != nil {
+				return resizeErr
+			}
+		case patch.Capacity != nil:
+			if resizeErr := resizeVolume(&vol, *patch.Capacity, nil); resizeErr != nil {
+				return resizeErr
+			}
+			if checkErr := s.checkKubeCapacity(vol); checkErr != nil {
+				return checkErr
+			}
+		}
+
+		if patch.AccessMode != nil {
+			vol.AccessMode = *patch.AccessMode
+		}
+
+		if patch.Label != nil && *patch.Label != vol.Label {
+			_, getErr := tx.VolumeByLabel(ctx, nsID, *patch.Label)
+			switch {
+			case getErr == nil:
+				return errors.ErrResourceAlreadyExists().AddDetailF("volume %s already exists", *patch.Label)
+			case !cherry.Equals(getErr, errors.ErrResourceNotExists()):
+				return getErr
+			}
+			vol.Label = *patch.Label
+		}
+
+		metadata, metaErr := patch.PatchMetadata(vol.Metadata)
+		if metaErr != nil {
+			return errors.ErrRequestValidationFailed().AddDetailsErr(metaErr)
+		}
+		vol.Metadata = metadata
+
+		if updErr := tx.UpdateVolume(ctx, &vol); updErr != nil {
+			return updErr
+		}
+
+		return s.applyVolumePatch(ctx, oldVol, vol)
+	})
+	if err != nil {
+		return model.VolumeResponse{}, err
+	}
+
+	if oldVol.Capacity != vol.Capacity {
+		s.capacityChanged(ctx, vol)
+		s.volumeResized(ctx, oldVol, vol)
+	}
+
+	return s.volumeResponse(ctx, vol), nil
+}
+
+// applyVolumePatch makes billing and kubernetes follow volume changes.
+// Renames are reverted if later step fails, resize can not be reverted.
+func (s *Server) applyVolumePatch(ctx context.Context, oldVol, vol model.Volume) error {
+	renamed := oldVol.Label != vol.Label
+	billingRenamed := false
+	kubeRenamed := false
+
+	revert := func() {
+		if kubeRenamed {
+			if err := s.kubeRenameVolume(ctx, oldVol, vol.Label); err != nil {
+				s.log.WithError(err).WithField("volume_id", vol.ID).Errorf("unable to revert volume rename in kubernetes")
+			}
+		}
+		if billingRenamed {
+			if err := s.clients.Billing.Rename(ctx, vol.ID, oldVol.Label); err != nil {
+				s.log.WithError(err).WithField("volume_id", vol.ID).Errorf("unable to revert volume rename in billing")
+			}
+		}
+	}
+
+	// kube rename goes first as it may be rejected by kube backend, e.g. if volume is in use
+	if renamed {
+		if err := s.kubeRenameVolume(ctx, vol, oldVol.Label); err != nil {
+			return err
+		}
+		kubeRenamed = true
+	}
+
+	if renamed && oldVol.TariffID != nil {
+		if err := s.clients.Billing.Rename(ctx, vol.ID, vol.Label); err != nil {
+			revert()
+			return err
+		}
+		billingRenamed = true
+	}
+
+	if oldVol.Capacity != vol.Capacity || oldVol.AccessMode != vol.AccessMode || !equalTariffs(oldVol.TariffID, vol.TariffID) {
+		if err := s.kubeUpdateVolume(ctx, vol); err != nil {
+			revert()
+			return err
+		}
+	}
+
+	return nil
+}
+
+func equalTariffs(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
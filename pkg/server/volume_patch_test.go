@@ -0,0 +1,32 @@
+package server
+
+import (
+	"testing"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	kubeModel "github.com/containerum/kube-client/pkg/model"
+)
+
+func TestPatchNotSupportedByKubeBackend(t *testing.T) {
+	vol := model.Volume{Resource: model.Resource{Label: "vol"}, NamespaceID: "ns", AccessMode: kubeModel.ReadWriteOnce}
+	label, readOnly, sameMode := "data", kubeModel.ReadOnlyMany, kubeModel.ReadWriteOnce
+	s := NewServer(newMemoryDB(), &Clients{Kube: clients.SingleKubeCluster("default", new(clients.KubeDirectClient))})
+
+	if err := s.checkKubePatch(vol, model.VolumePatch{Label: &label}); !cherry.Equals(err, errors.ErrRequestValidationFailed()) {
+		t.Errorf("expected rename to be rejected, got %v", err)
+	}
+	if err := s.checkKubePatch(vol, model.VolumePatch{AccessMode: &readOnly}); !cherry.Equals(err, errors.ErrRequestValidationFailed()) {
+		t.Errorf("expected access mode change to be rejected, got %v", err)
+	}
+	if err := s.checkKubePatch(vol, model.VolumePatch{Label: &vol.Label, AccessMode: &sameMode}); err != nil {
+		t.Errorf("expected unchanged label and access mode to be accepted, got %v", err)
+	}
+
+	s = NewServer(newMemoryDB(), &Clients{Kube: clients.SingleKubeCluster("default", clients.NewKubeAPIDummyClient())})
+	if err := s.checkKubePatch(vol, model.VolumePatch{Label: &label, AccessMode: &readOnly}); err != nil {
+		t.Errorf("expected dummy backend to accept patch, got %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/sirupsen/logrus"
+)
+
+// volumeWatchRetryInterval is a delay before reconnect after watch stream failure
+const volumeWatchRetryInterval = 10 * time.Second
+
+// SyncVolumeStatuses polls kube backend for state of every volume and updates volume statuses.
+// Volumes placed in ephemeral backends are skipped, they would be reported missing after restart.
+func (s *Server) SyncVolumeStatuses(ctx context.Context) error {
+	s.log.Debugf("sync volume statuses")
+
+	vols, err := s.db.AllVolumes(ctx, StandardVolumeFilter)
+	if err != nil {
+		return err
+	}
+
+	var updated, skipped, failed int
+	for i := range vols {
+		vol := &vols[i]
+
+		var upd model.VolumeStatusUpdate
+		var changed bool
+		cluster, err := s.volumeCluster(*vol)
+		if err != nil {
+			s.log.WithError(err).WithField("volume_id", vol.ID).Warnf("unable to get volume cluster")
+			failed++
+			continue
+		}
+		if ephemeral, ok := cluster.Client.(clients.KubeEphemeralBackend); ok && ephemeral.Ephemeral() {
+			skipped++
+			continue
+		}
+		kubeVol, getErr := cluster.Client.GetVolume(ctx, vol.NamespaceID, vol.Label)
+		switch {
+		case getErr == nil:
+			upd, changed = kubeVol.StatusUpdate(vol, time.Now().UTC())
+		case cherry.Equals(getErr, errors.ErrResourceNotExists()):
+			upd, changed = model.MissingStatusUpdate(vol, "volume not found in kubernetes")
+		default:
+			s.log.WithError(getErr).WithField("volume_id", vol.ID).Warnf("unable to get volume from kube backend")
+			failed++
+			continue
+		}
+		if !changed {
+			continue
+		}
+		if setErr := s.setVolumeStatus(ctx, vol, upd); setErr != nil {
+			failed++
+			continue
+		}
+		updated++
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"volumes": len(vols),
+		"updated": updated,
+		"skipped": skipped,
+		"failed":  failed,
+	}).Infof("volume statuses synchronized")
+
+	return nil
+}
+
+// WatchVolumeStatuses updates volume statuses from change streams of clusters until context is done.
+// Clusters which kube backend can not stream changes are skipped, SyncVolumeStatuses should be used for them.
+func (s *Server) WatchVolumeStatuses(ctx context.Context) {
+	var wg sync.WaitGroup
+	for _, cluster := range s.clients.Kube.Clusters() {
+		watcher, ok := cluster.Client.(clients.KubeVolumeWatcher)
+		if !ok {
+			s.log.WithField("cluster", cluster.Name).Infof("kube backend does not support watching volumes")
+			continue
+		}
+		wg.Add(1)
+		go func(cluster string, watcher clients.KubeVolumeWatcher) {
+			defer wg.Done()
+			s.watchClusterVolumes(ctx, cluster, watcher)
+		}(cluster.Name, watcher)
+	}
+	wg.Wait()
+}
+
+func (s *Server) watchClusterVolumes(ctx context.Context, cluster string, watcher clients.KubeVolumeWatcher) {
+	log := s.log.WithField("cluster", cluster)
+	log.Infof("watching volumes")
+	for {
+		err := watcher.WatchVolumes(ctx, func(kubeVol model.KubeVolume, deleted bool) {
+			s.handleVolumeEvent(ctx, cluster, kubeVol, deleted)
+		})
+		if err != nil {
+			log.WithError(err).Warnf("volumes watch failed")
+		}
+
+		select {
+		case <-ctx.Done():
+			log.Infof("volumes watch stopped")
+			return
+		case <-time.After(volumeWatchRetryInterval):
+		}
+	}
+}
+
+func (s *Server) handleVolumeEvent(ctx context.Context, cluster string, kubeVol model.KubeVolume, deleted bool) {
+	vol, err := s.db.VolumeByLabel(ctx, kubeVol.Namespace, kubeVol.Name)
+	if err != nil {
+		// volume deleted by us or not committed yet, next event or poll will catch up
+		if !cherry.Equals(err, errors.ErrResourceNotExists()) {
+			s.log.WithError(err).WithField("namespace", kubeVol.Namespace).Warnf("unable to get volume %s", kubeVol.Name)
+		}
+		return
+	}
+	if volCluster, err := s.volumeCluster(vol); err != nil || volCluster.Name != cluster {
+		// volume with the same name in other cluster
+		return
+	}
+
+	var upd model.VolumeStatusUpdate
+	var changed bool
+	if deleted {
+		upd, changed = model.MissingStatusUpdate(&vol, "volume deleted from kubernetes")
+	} else {
+		upd, changed = kubeVol.StatusUpdate(&vol, time.Now().UTC())
+	}
+	if changed {
+		s.setVolumeStatus(ctx, &vol, upd)
+	}
+}
+
+func (s *Server) setVolumeStatus(ctx context.Context, vol *model.Volume, upd model.VolumeStatusUpdate) error {
+	log := s.log.WithFields(logrus.Fields{
+		"volume_id":  vol.ID,
+		"old_status": vol.Status,
+		"status":     upd.Status,
+		"reason":     upd.Reason,
+	})
+	oldStatus := vol.Status
+	if err := s.db.SetVolumeStatus(ctx, vol, upd); err != nil {
+		log.WithError(err).Warnf("unable to update volume status")
+		return err
+	}
+	log.Infof("volume status changed")
+
+	if (oldStatus == model.VolumeStatusLost || oldStatus == model.VolumeStatusMissing) && vol.Status == model.VolumeStatusBound {
+		s.volumesEvent(ctx, model.VolumeRestoredEvent, *vol)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/clients"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+func TestSyncVolumeStatusesSkipsEphemeralBackend(t *testing.T) {
+	now := time.Now()
+	db := newMemoryDB()
+	// volume created before restart, dummy backend does not know it
+	db.volumes = []model.Volume{{
+		Resource:    model.Resource{ID: "vol-id", Label: "vol", CreateTime: &now},
+		NamespaceID: "ns",
+		Capacity:    model.GiBytes(1),
+		Status:      model.VolumeStatusBound,
+		BoundTime:   &now,
+	}}
+	s := NewServer(db, &Clients{
+		Kube: clients.SingleKubeCluster("default", clients.NewKubeAPIDummyClient()),
+	})
+
+	if err := s.SyncVolumeStatuses(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if status := db.volumes[0].Status; status != model.VolumeStatusBound {
+		t.Errorf("expected volume to stay bound, got %s", status)
+	}
+}
@@ -0,0 +1,124 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"github.com/containerum/cherry"
+	"github.com/sirupsen/logrus"
+)
+
+// GetVolumeViews returns volume as stored in database and as reported by kube backend.
+// Volume missing in one of places is not an error.
+func (s *Server) GetVolumeViews(ctx context.Context, nsID, label string) (model.VolumeViews, error) {
+	s.log.WithFields(logrus.Fields{
+		"ns_id": nsID,
+		"label": label,
+	}).Infof("get volume views")
+
+	var dbVol *model.Volume
+	vol, err := s.db.VolumeByLabel(ctx, nsID, label)
+	switch {
+	case err == nil:
+		vol.SetUnit(ResponseUnit(ctx))
+		dbVol = &vol
+	case !cherry.Equals(err, errors.ErrResourceNotExists()):
+		return model.VolumeViews{}, err
+	}
+
+	// volume is looked up in cluster it should be placed in first, then in other clusters
+	clusters := s.clients.Kube.Clusters()
+	var expectedCluster string
+	if dbVol != nil {
+		cluster, err := s.volumeCluster(*dbVol)
+		if err != nil {
+			return model.VolumeViews{}, err
+		}
+		expectedCluster = cluster.Name
+		sort.SliceStable(clusters, func(i, j int) bool { return clusters[i].Name == expectedCluster })
+	}
+
+	var kubeVol *model.KubeVolume
+	var kubeErrs []string
+	for _, cluster := range clusters {
+		kv, err := cluster.Client.GetVolume(ctx, nsID, label)
+		if err == nil {
+			kv.Cluster = cluster.Name
+			kubeVol = &kv
+			break
+		}
+		if !cherry.Equals(err, errors.ErrResourceNotExists()) {
+			kubeErrs = append(kubeErrs, fmt.Sprintf("%s: %v", cluster.Name, err))
+		}
+	}
+
+	if dbVol == nil && kubeVol == nil && len(kubeErrs) == 0 {
+		return model.VolumeViews{}, errors.ErrResourceNotExists().AddDetailF("volume %s not exists", label)
+	}
+
+	ret := model.NewVolumeViews(label, dbVol, kubeVol)
+	if dbVol != nil && kubeVol != nil && kubeVol.Cluster != expectedCluster {
+		ret.Mismatches = append(ret.Mismatches, "cluster")
+	}
+	ret.KubeError = strings.Join(kubeErrs, "; ")
+	return ret, nil
+}
+
+// GetNamespaceVolumeViews returns views of all volumes found in database or kube backend for namespace
+func (s *Server) GetNamespaceVolumeViews(ctx context.Context, nsID string) (model.NamespaceVolumeViews, error) {
+	s.log.WithField("ns_id", nsID).Infof("get namespace volume views")
+
+	vols, err := s.db.NamespaceVolumes(ctx, nsID)
+	if err != nil {
+		return model.NamespaceVolumeViews{}, err
+	}
+
+	var kubeVols []model.KubeVolume
+	for _, cluster := range s.clients.Kube.Clusters() {
+		clusterVols, err := cluster.Client.ListVolumes(ctx, nsID)
+		if err != nil {
+			return model.NamespaceVolumeViews{}, err
+		}
+		for i := range clusterVols {
+			clusterVols[i].Cluster = cluster.Name
+		}
+		kubeVols = append(kubeVols, clusterVols...)
+	}
+
+	dbByLabel := make(map[string]*model.Volume, len(vols))
+	for i := range vols {
+		vols[i].SetUnit(ResponseUnit(ctx))
+		dbByLabel[vols[i].Label] = &vols[i]
+	}
+	kubeByName := make(map[string]*model.KubeVolume, len(kubeVols))
+	for i := range kubeVols {
+		kubeByName[kubeVols[i].Name] = &kubeVols[i]
+	}
+
+	labels := make([]string, 0, len(dbByLabel)+len(kubeByName))
+	for label := range dbByLabel {
+		labels = append(labels, label)
+	}
+	for name := range kubeByName {
+		if _, ok := dbByLabel[name]; !ok {
+			labels = append(labels, name)
+		}
+	}
+	sort.Strings(labels)
+
+	ret := model.NamespaceVolumeViews{Volumes: make([]model.VolumeViews, len(labels))}
+	for i, label := range labels {
+		dbVol, kubeVol := dbByLabel[label], kubeByName[label]
+		ret.Volumes[i] = model.NewVolumeViews(label, dbVol, kubeVol)
+		if dbVol != nil && kubeVol != nil {
+			if cluster, err := s.volumeCluster(*dbVol); err != nil || cluster.Name != kubeVol.Cluster {
+				ret.Volumes[i].Mismatches = append(ret.Volumes[i].Mismatches, "cluster")
+			}
+		}
+	}
+	return ret, nil
+}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"time"
 
 	"git.containerum.net/ch/volume-manager/pkg/database"
 	"git.containerum.net/ch/volume-manager/pkg/errors"
@@ -20,16 +21,25 @@ var (
 type VolumeActions interface {
 	DirectCreateVolume(ctx context.Context, nsID string, req model.DirectVolumeCreateRequest) error
 	CreateVolume(ctx context.Context, nsID string, req model.VolumeCreateRequest) error
+	QuoteVolume(ctx context.Context, nsID string, req model.VolumeQuoteRequest) (model.VolumeQuote, error)
 	ImportVolume(ctx context.Context, nsID string, req kubeClientModel.Volume) error
-	AdminResizeVolume(ctx context.Context, nsID, label string, newCapacity int) error
+	AdminResizeVolume(ctx context.Context, nsID, label string, newCapacity model.Quantity) error
 	ResizeVolume(ctx context.Context, nsID, label string, newTariffID string) error
-	GetVolume(ctx context.Context, nsID, label string) (kubeClientModel.Volume, error)
-	GetUserVolumes(ctx context.Context) (kubeClientModel.VolumesList, error)
-	GetNamespaceVolumes(ctx context.Context, nsID string) (kubeClientModel.VolumesList, error)
-	GetAllVolumes(ctx context.Context, page, perPage int, filters ...string) (kubeClientModel.VolumesList, error)
+	PatchVolume(ctx context.Context, nsID, label string, patch model.VolumePatch) (model.VolumeResponse, error)
+	GetVolume(ctx context.Context, nsID, label string) (model.VolumeResponse, error)
+	GetUserVolumes(ctx context.Context, page model.PageRequest) (model.VolumesResponse, error)
+	GetNamespaceVolumes(ctx context.Context, nsID string, page model.PageRequest) (model.VolumesResponse, error)
+	GetAllVolumes(ctx context.Context, page model.PageRequest, sort []string, filters ...string) (model.VolumesResponse, error)
+	GetVolumeViews(ctx context.Context, nsID, label string) (model.VolumeViews, error)
+	GetNamespaceVolumeViews(ctx context.Context, nsID string) (model.NamespaceVolumeViews, error)
 	DeleteVolume(ctx context.Context, nsID, label string) error
 	DeleteAllNamespaceVolumes(ctx context.Context, nsID string) error
 	DeleteAllUserVolumes(ctx context.Context) error
+	ReconcileBilling(ctx context.Context, fix bool) (model.BillingReconciliationReport, error)
+	MigrateTariff(ctx context.Context, req model.TariffMigrationRequest) (model.TariffMigrationReport, error)
+	GetMetering(ctx context.Context, from, to time.Time) (model.MeteringReport, error)
+	TariffCacheStats(ctx context.Context) model.TariffCacheStats
+	InvalidateTariffCache(ctx context.Context, tariffIDs, nsIDs []string) model.TariffCacheStats
 }
 
 var StandardVolumeFilter = database.VolumeFilter{
@@ -44,23 +54,18 @@ func (s *Server) DirectCreateVolume(ctx context.Context, nsID string, req model.
 	userID := httputil.MustGetUserID(ctx)
 	s.log.WithFields(logrus.Fields{
 		"ns_id":    nsID,
-		"capacity": req.Capacity,
+		"capacity": req.Capacity.String(),
 		"label":    req.Label,
 		"user_id":  userID,
 	}).Infof("create volume")
 
-	var storage model.Storage
-	var err error
-	if req.Storage != "" {
-		storage, err = s.db.StorageByName(ctx, req.Storage)
-		if err != nil {
-			return err
-		}
-	} else {
-		storage, err = s.db.LeastUsedStorage(ctx, req.Capacity)
-		if err != nil {
-			return err
-		}
+	if err := s.checkNotSuspended(ctx, userID, nsID); err != nil {
+		return err
+	}
+
+	storage, err := s.chooseStorage(ctx, req.Storage, req.Capacity, storageConsumer(ctx, nsID))
+	if err != nil {
+		return err
 	}
 
 	if storage.Size-storage.Used-req.Capacity < 0 {
@@ -75,21 +80,30 @@ func (s *Server) DirectCreateVolume(ctx context.Context, nsID string, req model.
 		Capacity:    req.Capacity,
 		NamespaceID: nsID,
 		StorageName: storage.Name,
+		Cluster:     s.clients.Kube.StorageCluster(storage.Name).Name,
+	}
+
+	if err := s.checkKubeCapacity(volume); err != nil {
+		return err
 	}
 
-	return s.db.Transactional(func(tx database.DB) error {
+	err = s.db.Transactional(func(tx database.DB) error {
 		if createErr := tx.CreateVolume(ctx, &volume); createErr != nil {
 			return createErr
 		}
 
-		kubeVol := volume.ToKube()
-
-		if createErr := s.clients.KubeAPI.CreateVolume(ctx, nsID, &kubeVol); createErr != nil {
+		if createErr := s.kubeCreateVolume(ctx, volume); createErr != nil {
 			return createErr
 		}
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, volume)
+		s.volumesEvent(ctx, model.VolumeCreatedEvent, volume)
+	}
+
+	return err
 }
 
 func (s *Server) ImportVolume(ctx context.Context, nsID string, req kubeClientModel.Volume) error {
@@ -99,6 +113,7 @@ func (s *Server) ImportVolume(ctx context.Context, nsID string, req kubeClientMo
 		"label":    req.Name,
 	}).Infof("import volume")
 
+	var volume model.Volume
 	err := s.db.Transactional(func(tx database.DB) error {
 		storage, getErr := tx.StorageByName(ctx, req.StorageName)
 		if getErr != nil {
@@ -109,14 +124,15 @@ func (s *Server) ImportVolume(ctx context.Context, nsID string, req kubeClientMo
 			req.Owner = ZeroUUID
 		}
 
-		volume := model.Volume{
+		volume = model.Volume{
 			Resource: model.Resource{
 				Label:       req.Name,
 				OwnerUserID: req.Owner,
 			},
-			Capacity:    int(req.Capacity),
+			Capacity:    model.GiBytes(int(req.Capacity)),
 			NamespaceID: nsID,
 			StorageName: storage.Name,
+			Cluster:     s.clients.Kube.StorageCluster(storage.Name).Name,
 		}
 
 		if createErr := tx.CreateVolume(ctx, &volume); createErr != nil {
@@ -125,6 +141,10 @@ func (s *Server) ImportVolume(ctx context.Context, nsID string, req kubeClientMo
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, volume)
+		s.volumesEvent(ctx, model.VolumeCreatedEvent, volume)
+	}
 
 	return err
 }
@@ -138,11 +158,15 @@ func (s *Server) CreateVolume(ctx context.Context, nsID string, req model.Volume
 		"user_id":   userID,
 	}).Infof("create volume")
 
+	if err := s.checkNotSuspended(ctx, userID, nsID); err != nil {
+		return err
+	}
+
 	freeVolume := req.TariffID == ZeroUUID
 
 	var tariff billing.VolumeTariff
 	var nsTariff billing.NamespaceTariff
-	var volumeSize int
+	var volumeSize model.Quantity
 
 	var err error
 	if !freeVolume {
@@ -154,26 +178,18 @@ func (s *Server) CreateVolume(ctx context.Context, nsID string, req model.Volume
 		if err := CheckTariff(tariff.Tariff, IsAdminRole(ctx)); err != nil {
 			return err
 		}
-		volumeSize = tariff.StorageLimit
+		volumeSize = model.GiBytes(tariff.StorageLimit)
 	} else {
 		nsTariff, err = s.clients.Billing.GetTariffForNamespace(ctx, nsID)
 		if err != nil {
 			return err
 		}
-		volumeSize = nsTariff.VolumeSize
+		volumeSize = model.GiBytes(nsTariff.VolumeSize)
 	}
 
-	var storage model.Storage
-	if req.Storage != "" {
-		storage, err = s.db.StorageByName(ctx, req.Storage)
-		if err != nil {
-			return err
-		}
-	} else {
-		storage, err = s.db.LeastUsedStorage(ctx, volumeSize)
-		if err != nil {
-			return err
-		}
+	storage, err := s.chooseStorage(ctx, req.Storage, volumeSize, storageConsumer(ctx, nsID))
+	if err != nil {
+		return err
 	}
 
 	if volumeSize == 0 {
@@ -194,6 +210,7 @@ func (s *Server) CreateVolume(ctx context.Context, nsID string, req model.Volume
 		Capacity:    volumeSize,
 		NamespaceID: nsID,
 		StorageName: storage.Name,
+		Cluster:     s.clients.Kube.StorageCluster(storage.Name).Name,
 	}
 
 	if !freeVolume {
@@ -208,20 +225,25 @@ func (s *Server) CreateVolume(ctx context.Context, nsID string, req model.Volume
 		}
 	}
 
-	return s.db.Transactional(func(tx database.DB) error {
+	err = s.db.Transactional(func(tx database.DB) error {
 		if createErr := tx.CreateVolume(ctx, &volume); createErr != nil {
 			return createErr
 		}
-		kubeVol := volume.ToKube()
-		if createErr := s.clients.KubeAPI.CreateVolume(ctx, nsID, &kubeVol); createErr != nil {
+		if createErr := s.kubeCreateVolume(ctx, volume); createErr != nil {
 			return createErr
 		}
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, volume)
+		s.volumesEvent(ctx, model.VolumeCreatedEvent, volume)
+	}
+
+	return err
 }
 
-func (s *Server) GetVolume(ctx context.Context, nsID, label string) (kubeClientModel.Volume, error) {
+func (s *Server) GetVolume(ctx context.Context, nsID, label string) (model.VolumeResponse, error) {
 	userID := httputil.MustGetUserID(ctx)
 	s.log.WithFields(logrus.Fields{
 		"user_id": userID,
@@ -231,75 +253,68 @@ func (s *Server) GetVolume(ctx context.Context, nsID, label string) (kubeClientM
 
 	vol, err := s.db.VolumeByLabel(ctx, nsID, label)
 	if err != nil {
-		return kubeClientModel.Volume{}, err
+		return model.VolumeResponse{}, err
 	}
 
-	return vol.ToKube(), nil
+	return s.volumeResponse(ctx, vol), nil
 }
 
-func (s *Server) GetNamespaceVolumes(ctx context.Context, nsID string) (kubeClientModel.VolumesList, error) {
+func (s *Server) GetNamespaceVolumes(ctx context.Context, nsID string, page model.PageRequest) (model.VolumesResponse, error) {
 	userID := httputil.MustGetUserID(ctx)
 	s.log.WithFields(logrus.Fields{
 		"user_id":      userID,
 		"namespace_id": nsID,
+		"page":         page,
 	}).Infof("get namespace volumes")
 
-	vols, err := s.db.NamespaceVolumes(ctx, nsID)
+	filter := StandardVolumeFilter
+	filter.NamespaceID = nsID
+	vols, pagination, err := s.pageVolumes(ctx, filter, page)
 	if err != nil {
-		return kubeClientModel.VolumesList{}, err
+		return model.VolumesResponse{}, err
 	}
 
-	ret := make([]kubeClientModel.Volume, len(vols))
-	for i := range vols {
-		ret[i] = vols[i].ToKube()
-	}
-
-	return kubeClientModel.VolumesList{ret}, nil
+	return s.volumesResponse(ctx, vols, pagination), nil
 }
 
-func (s *Server) GetUserVolumes(ctx context.Context) (kubeClientModel.VolumesList, error) {
+func (s *Server) GetUserVolumes(ctx context.Context, page model.PageRequest) (model.VolumesResponse, error) {
 	userID := httputil.MustGetUserID(ctx)
-	s.log.WithField("user_id", userID).Infof("get user volumes")
+	s.log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"page":    page,
+	}).Infof("get user volumes")
 
-	vols, err := s.db.UserVolumes(ctx, userID)
+	filter := StandardVolumeFilter
+	filter.OwnerUserID = userID
+	vols, pagination, err := s.pageVolumes(ctx, filter, page)
 	if err != nil {
-		return kubeClientModel.VolumesList{}, err
-	}
-
-	ret := make([]kubeClientModel.Volume, len(vols))
-	for i := range vols {
-		ret[i] = vols[i].ToKube()
+		return model.VolumesResponse{}, err
 	}
 
-	return kubeClientModel.VolumesList{ret}, nil
+	return s.volumesResponse(ctx, vols, pagination), nil
 }
 
-func (s *Server) GetAllVolumes(ctx context.Context, page, perPage int, filters ...string) (kubeClientModel.VolumesList, error) {
+// GetAllVolumes returns volumes matching filters, see database.ParseVolumeFilter for filters syntax
+func (s *Server) GetAllVolumes(ctx context.Context, page model.PageRequest, sort []string, filters ...string) (model.VolumesResponse, error) {
 	s.log.WithFields(logrus.Fields{
-		"page":     page,
-		"per_page": perPage,
-		"filters":  filters,
+		"page":    page,
+		"sort":    sort,
+		"filters": filters,
 	}).Infof("get all volumes")
 
-	var filter database.VolumeFilter
-	if len(filters) > 0 {
-		filter = database.ParseVolumeFilter()
-	} else {
-		filter = StandardVolumeFilter
-	}
-	filter.PerPage = perPage
-	filter.Page = page
-	vols, err := s.db.AllVolumes(ctx, filter)
+	filter, err := database.ParseVolumeFilter(filters...)
 	if err != nil {
-		return kubeClientModel.VolumesList{}, err
+		return model.VolumesResponse{}, errors.ErrRequestValidationFailed().AddDetailsErr(err)
 	}
-
-	ret := make([]kubeClientModel.Volume, len(vols))
-	for i := range vols {
-		ret[i] = vols[i].ToKube()
+	if filter.Sort, err = database.ParseVolumeSort(sort...); err != nil {
+		return model.VolumesResponse{}, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+	vols, pagination, err := s.pageVolumes(ctx, filter, page)
+	if err != nil {
+		return model.VolumesResponse{}, err
 	}
 
-	return kubeClientModel.VolumesList{ret}, nil
+	return s.volumesResponse(ctx, vols, pagination), nil
 }
 
 func (s *Server) DeleteVolume(ctx context.Context, nsID, label string) error {
@@ -310,9 +325,10 @@ func (s *Server) DeleteVolume(ctx context.Context, nsID, label string) error {
 		"label":   label,
 	}).Infof("delete volume")
 
+	var vol model.Volume
 	err := s.db.Transactional(func(tx database.DB) error {
-		vol, getErr := tx.VolumeByLabel(ctx, nsID, label)
-		if getErr != nil {
+		var getErr error
+		if vol, getErr = tx.VolumeByLabel(ctx, nsID, label); getErr != nil {
 			return getErr
 		}
 
@@ -321,7 +337,7 @@ func (s *Server) DeleteVolume(ctx context.Context, nsID, label string) error {
 			return delErr
 		}
 
-		if createErr := s.clients.KubeAPI.DeleteVolume(ctx, nsID, vol.Label); createErr != nil {
+		if createErr := s.kubeDeleteVolume(ctx, vol); createErr != nil {
 			return createErr
 		}
 
@@ -331,6 +347,10 @@ func (s *Server) DeleteVolume(ctx context.Context, nsID, label string) error {
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, vol)
+		s.volumesEvent(ctx, model.VolumeDeletedEvent, vol)
+	}
 
 	return err
 }
@@ -339,8 +359,10 @@ func (s *Server) DeleteAllUserVolumes(ctx context.Context) error {
 	userID := httputil.MustGetUserID(ctx)
 	s.log.WithField("user_id", userID).Infof("delete all user volumes")
 
+	var vols []model.Volume
 	err := s.db.Transactional(func(tx database.DB) error {
-		vols, err := s.db.UserVolumes(ctx, userID)
+		var err error
+		vols, err = s.db.UserVolumes(ctx, userID)
 		switch {
 		case err == nil:
 			// pass
@@ -364,6 +386,10 @@ func (s *Server) DeleteAllUserVolumes(ctx context.Context) error {
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, vols...)
+		s.volumesEvent(ctx, model.VolumeDeletedEvent, vols...)
+	}
 
 	return err
 }
@@ -375,8 +401,10 @@ func (s *Server) DeleteAllNamespaceVolumes(ctx context.Context, nsID string) err
 		"namespace_id": nsID,
 	}).Infof("delete all user volumes")
 
+	var vols []model.Volume
 	err := s.db.Transactional(func(tx database.DB) error {
-		vols, err := s.db.NamespaceVolumes(ctx, nsID)
+		var err error
+		vols, err = s.db.NamespaceVolumes(ctx, nsID)
 		switch {
 		case err == nil:
 			// pass
@@ -400,44 +428,53 @@ func (s *Server) DeleteAllNamespaceVolumes(ctx context.Context, nsID string) err
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, vols...)
+		s.volumesEvent(ctx, model.VolumeDeletedEvent, vols...)
+	}
 
 	return err
 }
 
-func (s *Server) AdminResizeVolume(ctx context.Context, nsID, label string, newCapacity int) error {
+func (s *Server) AdminResizeVolume(ctx context.Context, nsID, label string, newCapacity model.Quantity) error {
 	userID := httputil.MustGetUserID(ctx)
 	s.log.WithFields(logrus.Fields{
 		"user_id":      userID,
 		"ns_id":        nsID,
 		"label":        label,
-		"new_capacity": newCapacity,
+		"new_capacity": newCapacity.String(),
 	}).Infof("resize volume")
 
+	var oldVol, vol model.Volume
 	err := s.db.Transactional(func(tx database.DB) error {
-		vol, getErr := tx.VolumeByLabel(ctx, nsID, label)
-		if getErr != nil {
+		var getErr error
+		if vol, getErr = tx.VolumeByLabel(ctx, nsID, label); getErr != nil {
 			return getErr
 		}
+		oldVol = vol
 
-		if newCapacity < vol.Capacity {
-			return errors.ErrDownResize()
+		if resizeErr := resizeVolume(&vol, newCapacity, nil); resizeErr != nil {
+			return resizeErr
 		}
 
-		vol.TariffID = nil
-		vol.Capacity = newCapacity
+		if checkErr := s.checkKubeCapacity(vol); checkErr != nil {
+			return checkErr
+		}
 
 		if resizeErr := tx.UpdateVolume(ctx, &vol); resizeErr != nil {
 			return resizeErr
 		}
 
-		kubeVol := vol.ToKube()
-
-		if createErr := s.clients.KubeAPI.UpdateVolume(ctx, nsID, &kubeVol); createErr != nil {
+		if createErr := s.kubeUpdateVolume(ctx, vol); createErr != nil {
 			return createErr
 		}
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, vol)
+		s.volumeResized(ctx, oldVol, vol)
+	}
 
 	return err
 }
@@ -451,40 +488,65 @@ func (s *Server) ResizeVolume(ctx context.Context, nsID, label string, newTariff
 		"new_tariff_id": newTariffID,
 	}).Infof("resize volume")
 
-	newTariff, err := s.clients.Billing.GetVolumeTariff(ctx, newTariffID)
+	newTariff, err := s.volumeTariff(ctx, newTariffID)
 	if err != nil {
 		return err
 	}
 
-	if chkErr := CheckTariff(newTariff.Tariff, IsAdminRole(ctx)); chkErr != nil {
-		return chkErr
-	}
-
+	var oldVol, vol model.Volume
 	err = s.db.Transactional(func(tx database.DB) error {
-		vol, getErr := tx.VolumeByLabel(ctx, nsID, label)
-		if getErr != nil {
+		var getErr error
+		if vol, getErr = tx.VolumeByLabel(ctx, nsID, label); getErr != nil {
 			return getErr
 		}
+		oldVol = vol
 
-		if newTariff.StorageLimit < vol.Capacity {
-			return errors.ErrDownResize()
+		if resizeErr := resizeVolume(&vol, model.GiBytes(newTariff.StorageLimit), &newTariff.ID); resizeErr != nil {
+			return resizeErr
 		}
 
-		vol.TariffID = &newTariff.ID
-		vol.Capacity = newTariff.StorageLimit
-
 		if resizeErr := tx.UpdateVolume(ctx, &vol); resizeErr != nil {
 			return resizeErr
 		}
 
-		kubeVol := vol.ToKube()
-
-		if createErr := s.clients.KubeAPI.UpdateVolume(ctx, nsID, &kubeVol); createErr != nil {
+		if createErr := s.kubeUpdateVolume(ctx, vol); createErr != nil {
 			return createErr
 		}
 
 		return nil
 	})
+	if err == nil {
+		s.capacityChanged(ctx, vol)
+		s.volumeResized(ctx, oldVol, vol)
+	}
 
 	return err
 }
+
+// volumeTariff returns volume tariff checking it is available for user
+func (s *Server) volumeTariff(ctx context.Context, tariffID string) (billing.VolumeTariff, error) {
+	tariff, err := s.clients.Billing.GetVolumeTariff(ctx, tariffID)
+	if err != nil {
+		return tariff, err
+	}
+
+	if chkErr := CheckTariff(tariff.Tariff, IsAdminRole(ctx)); chkErr != nil {
+		return tariff, chkErr
+	}
+	return tariff, nil
+}
+
+// resizeVolume sets new capacity and tariff of volume. Suspended volumes can not be resized and volumes can not shrink.
+func resizeVolume(vol *model.Volume, newCapacity model.Quantity, tariffID *string) error {
+	if suspendedErr := volumeSuspendedError(*vol); suspendedErr != nil {
+		return suspendedErr
+	}
+
+	if newCapacity < vol.Capacity {
+		return errors.ErrDownResize()
+	}
+
+	vol.TariffID = tariffID
+	vol.Capacity = newCapacity
+	return nil
+}
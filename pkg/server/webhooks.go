@@ -0,0 +1,281 @@
+package server
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/database"
+	"git.containerum.net/ch/volume-manager/pkg/errors"
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"git.containerum.net/ch/volume-manager/pkg/webhooks"
+	"github.com/satori/go.uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// WebhookActions manages webhook subscriptions and their delivery logs
+type WebhookActions interface {
+	CreateWebhook(ctx context.Context, req model.WebhookSubscriptionRequest) (model.WebhookSubscription, error)
+	GetWebhooks(ctx context.Context) ([]model.WebhookSubscription, error)
+	DeleteWebhook(ctx context.Context, id string) error
+	GetWebhookDeliveries(ctx context.Context, id string, status model.WebhookDeliveryStatus, page model.PageRequest) ([]model.WebhookDelivery, model.Pagination, error)
+}
+
+const (
+	// webhookDeliveryLease is a time claimed delivery is hidden from other workers
+	webhookDeliveryLease = 5 * time.Minute
+	// webhookDeliveryBatch is a number of deliveries sent by one DeliverWebhooks call
+	webhookDeliveryBatch = 20
+	webhookSecretBytes   = 32
+)
+
+// WithWebhooks enables recording events for webhook subscriptions. Events are sent by DeliverWebhooks.
+// storage.near_full event is emitted when storage fill level reaches one of near full thresholds (in percents),
+// it is not emitted if thresholds are empty.
+func WithWebhooks(sender webhooks.Sender, nearFullThresholds ...float64) Option {
+	return func(s *Server) {
+		s.webhookSender = sender
+		s.storageNearFullThresholds = newCapacityThresholds(nearFullThresholds)
+	}
+}
+
+// CreateWebhook registers subscription. Secret is generated if not provided and returned only here.
+func (s *Server) CreateWebhook(ctx context.Context, req model.WebhookSubscriptionRequest) (model.WebhookSubscription, error) {
+	s.log.WithFields(logrus.Fields{
+		"url":    req.URL,
+		"events": req.Events,
+	}).Infof("create webhook")
+
+	if err := req.Validate(); err != nil {
+		return model.WebhookSubscription{}, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+
+	sub := model.WebhookSubscription{
+		URL:    req.URL,
+		Events: req.Events,
+		Secret: req.Secret,
+	}
+	if sub.Secret == "" {
+		secret := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(secret); err != nil {
+			return model.WebhookSubscription{}, errors.ErrInternal().Log(err, s.log)
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+
+	if err := s.db.CreateWebhookSubscription(ctx, &sub); err != nil {
+		return model.WebhookSubscription{}, err
+	}
+
+	return sub, nil
+}
+
+// GetWebhooks returns subscriptions without secrets
+func (s *Server) GetWebhooks(ctx context.Context) ([]model.WebhookSubscription, error) {
+	s.log.Infof("get webhooks")
+
+	subs, err := s.db.WebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range subs {
+		subs[i].Secret = ""
+	}
+	return subs, nil
+}
+
+// DeleteWebhook deletes subscription with its delivery logs
+func (s *Server) DeleteWebhook(ctx context.Context, id string) error {
+	s.log.WithField("id", id).Infof("delete webhook")
+
+	return s.db.DeleteWebhookSubscription(ctx, id)
+}
+
+// GetWebhookDeliveries returns page of subscription deliveries, newest first. Empty status matches all deliveries.
+func (s *Server) GetWebhookDeliveries(ctx context.Context, id string, status model.WebhookDeliveryStatus, page model.PageRequest) ([]model.WebhookDelivery, model.Pagination, error) {
+	s.log.WithFields(logrus.Fields{
+		"id":     id,
+		"status": status,
+		"page":   page,
+	}).Infof("get webhook deliveries")
+
+	if err := page.Validate(); err != nil {
+		return nil, model.Pagination{}, errors.ErrRequestValidationFailed().AddDetailsErr(err)
+	}
+	if page.Cursor != "" {
+		return nil, model.Pagination{}, errors.ErrRequestValidationFailed().AddDetailF("webhook deliveries do not support cursor pagination")
+	}
+	switch status {
+	case "", model.WebhookDeliveryPending, model.WebhookDeliveryDelivered, model.WebhookDeliveryFailed:
+	default:
+		return nil, model.Pagination{}, errors.ErrRequestValidationFailed().AddDetailF("unknown delivery status %q", status)
+	}
+
+	subs, err := s.db.WebhookSubscriptions(ctx)
+	if err != nil {
+		return nil, model.Pagination{}, err
+	}
+	found := false
+	for _, sub := range subs {
+		found = found || sub.ID == id
+	}
+	if !found {
+		return nil, model.Pagination{}, errors.ErrResourceNotExists().AddDetailF("webhook subscription %s not exists", id)
+	}
+
+	filter := database.WebhookDeliveryFilter{
+		Page:           page.Page,
+		PerPage:        page.PerPage,
+		SubscriptionID: id,
+		Status:         status,
+	}
+	// delivery logs grow unbounded, so they are always paginated
+	if !page.Paginated() {
+		filter.PerPage = model.DefaultPerPage
+	}
+	if filter.Page < 1 {
+		filter.Page = 1
+	}
+
+	total, err := s.db.CountWebhookDeliveries(ctx, filter)
+	if err != nil {
+		return nil, model.Pagination{}, err
+	}
+	deliveries, err := s.db.WebhookDeliveries(ctx, filter)
+	if err != nil {
+		return nil, model.Pagination{}, err
+	}
+
+	return deliveries, model.Pagination{
+		Total:   total,
+		Page:    filter.Page,
+		PerPage: filter.PerPage,
+	}, nil
+}
+
+// DeliverWebhooks sends due deliveries. Failed deliveries are retried with backoff until attempts are exhausted.
+func (s *Server) DeliverWebhooks(ctx context.Context) error {
+	if s.webhookSender == nil {
+		return nil
+	}
+	s.log.Debugf("deliver webhooks")
+
+	deliveries, err := s.db.ClaimWebhookDeliveries(ctx, time.Now().UTC(), webhookDeliveryLease, webhookDeliveryBatch)
+	if err != nil || len(deliveries) == 0 {
+		return err
+	}
+
+	subs, err := s.db.WebhookSubscriptions(ctx)
+	if err != nil {
+		return err
+	}
+	subsByID := make(map[string]model.WebhookSubscription, len(subs))
+	for _, sub := range subs {
+		subsByID[sub.ID] = sub
+	}
+
+	var delivered, failed int
+	for i := range deliveries {
+		delivery := &deliveries[i]
+		sub, ok := subsByID[delivery.SubscriptionID]
+		if !ok {
+			// subscription deleted after claim, deliveries are deleted with it
+			continue
+		}
+
+		log := s.log.WithFields(logrus.Fields{
+			"delivery_id":     delivery.ID,
+			"subscription_id": sub.ID,
+			"event":           delivery.Event,
+		})
+		status, sendErr := s.webhookSender.Send(ctx, sub, *delivery)
+		if sendErr != nil {
+			delivery.AttemptFailed(time.Now().UTC(), status, sendErr)
+			log.WithError(sendErr).WithField("attempts", delivery.Attempts).Warnf("webhook delivery attempt failed")
+			failed++
+		} else {
+			delivery.AttemptSucceeded(time.Now().UTC(), status)
+			delivered++
+		}
+
+		if updErr := s.db.UpdateWebhookDelivery(ctx, delivery); updErr != nil {
+			log.WithError(updErr).Warnf("unable to update webhook delivery")
+		}
+	}
+
+	s.log.WithFields(logrus.Fields{
+		"delivered": delivered,
+		"failed":    failed,
+	}).Infof("webhooks delivered")
+
+	return nil
+}
+
+// emitWebhookEvent records deliveries of event for subscribed endpoints.
+// Changes are already committed at this point, so errors are only logged.
+func (s *Server) emitWebhookEvent(ctx context.Context, payload model.WebhookPayload) {
+	if s.webhookSender == nil {
+		return
+	}
+
+	log := s.log.WithField("event", payload.Event)
+	subs, err := s.db.WebhookSubscriptions(ctx)
+	if err != nil {
+		log.WithError(err).Warnf("unable to get webhook subscriptions")
+		return
+	}
+
+	now := time.Now().UTC()
+	payload.ID = uuid.NewV4().String()
+	payload.Time = now
+
+	var deliveries []model.WebhookDelivery
+	for _, sub := range subs {
+		if !sub.Subscribed(payload.Event) {
+			continue
+		}
+		deliveries = append(deliveries, model.WebhookDelivery{
+			SubscriptionID:  sub.ID,
+			Event:           payload.Event,
+			Payload:         payload,
+			Status:          model.WebhookDeliveryPending,
+			NextAttemptTime: &now,
+		})
+	}
+
+	if err := s.db.CreateWebhookDeliveries(ctx, deliveries); err != nil {
+		log.WithError(err).Warnf("unable to record webhook deliveries")
+	}
+}
+
+// volumesEvent emits event for every volume
+func (s *Server) volumesEvent(ctx context.Context, event model.WebhookEvent, vols ...model.Volume) {
+	if s.webhookSender == nil {
+		return
+	}
+	for _, vol := range vols {
+		s.emitWebhookEvent(ctx, model.WebhookPayload{
+			Event:  event,
+			Volume: s.webhookVolume(ctx, vol),
+		})
+	}
+}
+
+// volumeResized emits resize event if capacity changed
+func (s *Server) volumeResized(ctx context.Context, oldVol, vol model.Volume) {
+	if s.webhookSender == nil || oldVol.Capacity == vol.Capacity {
+		return
+	}
+	s.emitWebhookEvent(ctx, model.WebhookPayload{
+		Event:          model.VolumeResizedEvent,
+		Volume:         s.webhookVolume(ctx, vol),
+		PreviousVolume: s.webhookVolume(ctx, oldVol),
+	})
+}
+
+// webhookVolume represents volume in default units regardless of units requested by client
+func (s *Server) webhookVolume(ctx context.Context, vol model.Volume) *model.VolumeResponse {
+	ret := s.volumeResponse(WithResponseUnit(ctx, model.DefaultUnit), vol)
+	return &ret
+}
@@ -0,0 +1,37 @@
+package periodic
+
+import (
+	"context"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Run calls job every interval until context is done. Job errors are logged.
+// Zero or negative interval disables job.
+func Run(ctx context.Context, name string, interval time.Duration, job func(ctx context.Context) error) {
+	log := logrus.WithField("job", name)
+	if interval <= 0 {
+		log.Infof("periodic job disabled")
+		return
+	}
+
+	log.WithField("interval", interval).Infof("periodic job started")
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("periodic job stopped")
+			return
+		case <-ticker.C:
+			start := time.Now()
+			if err := job(ctx); err != nil {
+				log.WithError(err).Errorf("periodic job failed")
+				continue
+			}
+			log.WithField("duration", time.Since(start)).Debugf("periodic job done")
+		}
+	}
+}
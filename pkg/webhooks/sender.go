@@ -0,0 +1,61 @@
+// Package webhooks contains senders of webhook deliveries.
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+	"gopkg.in/resty.v1"
+)
+
+// Sender makes one attempt of delivery to subscription.
+// Returned status is HTTP status of response or zero if request was not sent.
+type Sender interface {
+	Send(ctx context.Context, subscription model.WebhookSubscription, delivery model.WebhookDelivery) (status int, err error)
+}
+
+// HTTPSender posts delivery payload as JSON signed with subscription secret
+type HTTPSender struct {
+	client *resty.Client
+}
+
+func NewHTTPSender(timeout time.Duration) *HTTPSender {
+	client := resty.New().
+		SetTimeout(timeout).
+		SetRedirectPolicy(resty.NoRedirectPolicy()).
+		SetHeader("Content-Type", "application/json")
+	return &HTTPSender{client: client}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, subscription model.WebhookSubscription, delivery model.WebhookDelivery) (int, error) {
+	// payload contains maps, so encoding/json is used instead of client marshaller
+	body, err := json.Marshal(delivery.Payload)
+	if err != nil {
+		return 0, err
+	}
+	timestamp := time.Now().Unix()
+
+	resp, err := s.client.R().
+		SetContext(ctx).
+		SetHeader(model.WebhookEventHeader, string(delivery.Event)).
+		SetHeader(model.WebhookDeliveryHeader, delivery.ID).
+		SetHeader(model.WebhookTimestampHeader, strconv.FormatInt(timestamp, 10)).
+		SetHeader(model.WebhookSignatureHeader, model.SignWebhook(subscription.Secret, timestamp, body)).
+		SetBody(body).
+		Post(subscription.URL)
+	if err != nil {
+		return 0, err
+	}
+	if resp.StatusCode() < 200 || resp.StatusCode() >= 300 {
+		return resp.StatusCode(), fmt.Errorf("webhook responded with %s", resp.Status())
+	}
+	return resp.StatusCode(), nil
+}
+
+func (s *HTTPSender) String() string {
+	return fmt.Sprintf("webhook sender, timeout %v", s.client.GetClient().Timeout)
+}
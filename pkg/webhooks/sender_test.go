@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"git.containerum.net/ch/volume-manager/pkg/models"
+)
+
+func TestHTTPSender(t *testing.T) {
+	const secret = "secret"
+	responseStatus := http.StatusNoContent
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		timestamp, err := strconv.ParseInt(r.Header.Get(model.WebhookTimestampHeader), 10, 64)
+		if err != nil {
+			t.Errorf("bad timestamp header: %v", err)
+		}
+		if sig := r.Header.Get(model.WebhookSignatureHeader); sig != model.SignWebhook(secret, timestamp, body) {
+			t.Errorf("bad signature %q", sig)
+		}
+		if event := r.Header.Get(model.WebhookEventHeader); event != string(model.VolumeCreatedEvent) {
+			t.Errorf("bad event header %q", event)
+		}
+		if id := r.Header.Get(model.WebhookDeliveryHeader); id != "delivery" {
+			t.Errorf("bad delivery header %q", id)
+		}
+		w.WriteHeader(responseStatus)
+	}))
+	defer server.Close()
+
+	sender := NewHTTPSender(time.Second)
+	sub := model.WebhookSubscription{URL: server.URL, Secret: secret}
+	delivery := model.WebhookDelivery{
+		ID:    "delivery",
+		Event: model.VolumeCreatedEvent,
+		Payload: model.WebhookPayload{
+			Event:  model.VolumeCreatedEvent,
+			Volume: &model.VolumeResponse{Metadata: map[string]string{"key": "value"}},
+		},
+	}
+
+	status, err := sender.Send(context.Background(), sub, delivery)
+	if err != nil || status != http.StatusNoContent {
+		t.Fatalf("expected delivery, got status %d, error %v", status, err)
+	}
+
+	responseStatus = http.StatusInternalServerError
+	status, err = sender.Send(context.Background(), sub, delivery)
+	if err == nil || status != http.StatusInternalServerError {
+		t.Fatalf("expected failure with status 500, got status %d, error %v", status, err)
+	}
+}